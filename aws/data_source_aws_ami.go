@@ -233,15 +233,27 @@ func dataSourceAwsAmiRead(d *schema.ResourceData, meta interface{}) error {
 				"specific search criteria, or set `most_recent` attribute to true.")
 		}
 		sort.Slice(filteredImages, func(i, j int) bool {
-			itime, _ := time.Parse(time.RFC3339, aws.StringValue(filteredImages[i].CreationDate))
-			jtime, _ := time.Parse(time.RFC3339, aws.StringValue(filteredImages[j].CreationDate))
-			return itime.Unix() > jtime.Unix()
+			return amiCreatedAfter(filteredImages[i], filteredImages[j])
 		})
 	}
 
 	return amiDescriptionAttributes(d, filteredImages[0])
 }
 
+// amiCreatedAfter reports whether image a was created after image b. Images
+// with the same creation date are ordered by image ID so that most_recent and
+// sorted results do not change between refreshes.
+func amiCreatedAfter(a, b *ec2.Image) bool {
+	aTime, _ := time.Parse(time.RFC3339, aws.StringValue(a.CreationDate))
+	bTime, _ := time.Parse(time.RFC3339, aws.StringValue(b.CreationDate))
+
+	if !aTime.Equal(bTime) {
+		return aTime.After(bTime)
+	}
+
+	return aws.StringValue(a.ImageId) > aws.StringValue(b.ImageId)
+}
+
 // populate the numerous fields that the image description returns.
 func amiDescriptionAttributes(d *schema.ResourceData, image *ec2.Image) error {
 	// Simple attributes first
@@ -5,9 +5,7 @@ import (
 	"log"
 	"regexp"
 	"sort"
-	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -98,13 +96,12 @@ func dataSourceAwsAmiIdsRead(d *schema.ResourceData, meta interface{}) error {
 		filteredImages = resp.Images[:]
 	}
 
+	sortAscending := d.Get("sort_ascending").(bool)
 	sort.Slice(filteredImages, func(i, j int) bool {
-		itime, _ := time.Parse(time.RFC3339, aws.StringValue(filteredImages[i].CreationDate))
-		jtime, _ := time.Parse(time.RFC3339, aws.StringValue(filteredImages[j].CreationDate))
-		if d.Get("sort_ascending").(bool) {
-			return itime.Unix() < jtime.Unix()
+		if sortAscending {
+			return amiCreatedAfter(filteredImages[j], filteredImages[i])
 		}
-		return itime.Unix() > jtime.Unix()
+		return amiCreatedAfter(filteredImages[i], filteredImages[j])
 	})
 	for _, image := range filteredImages {
 		imageIds = append(imageIds, *image.ImageId)
@@ -5,10 +5,60 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func TestAmiCreatedAfter(t *testing.T) {
+	testCases := []struct {
+		name string
+		a    *ec2.Image
+		b    *ec2.Image
+		want bool
+	}{
+		{
+			name: "newer",
+			a:    &ec2.Image{CreationDate: aws.String("2019-05-02T10:00:00.000Z"), ImageId: aws.String("ami-11111111")},
+			b:    &ec2.Image{CreationDate: aws.String("2019-05-01T10:00:00.000Z"), ImageId: aws.String("ami-22222222")},
+			want: true,
+		},
+		{
+			name: "older",
+			a:    &ec2.Image{CreationDate: aws.String("2019-05-01T10:00:00.000Z"), ImageId: aws.String("ami-22222222")},
+			b:    &ec2.Image{CreationDate: aws.String("2019-05-02T10:00:00.000Z"), ImageId: aws.String("ami-11111111")},
+			want: false,
+		},
+		{
+			name: "sub-second difference",
+			a:    &ec2.Image{CreationDate: aws.String("2019-05-01T10:00:00.500Z"), ImageId: aws.String("ami-11111111")},
+			b:    &ec2.Image{CreationDate: aws.String("2019-05-01T10:00:00.000Z"), ImageId: aws.String("ami-22222222")},
+			want: true,
+		},
+		{
+			name: "same creation date higher ID",
+			a:    &ec2.Image{CreationDate: aws.String("2019-05-01T10:00:00.000Z"), ImageId: aws.String("ami-22222222")},
+			b:    &ec2.Image{CreationDate: aws.String("2019-05-01T10:00:00.000Z"), ImageId: aws.String("ami-11111111")},
+			want: true,
+		},
+		{
+			name: "same creation date lower ID",
+			a:    &ec2.Image{CreationDate: aws.String("2019-05-01T10:00:00.000Z"), ImageId: aws.String("ami-11111111")},
+			b:    &ec2.Image{CreationDate: aws.String("2019-05-01T10:00:00.000Z"), ImageId: aws.String("ami-22222222")},
+			want: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if got := amiCreatedAfter(testCase.a, testCase.b); got != testCase.want {
+				t.Errorf("got %t, expected %t", got, testCase.want)
+			}
+		})
+	}
+}
+
 func TestAccAWSAmiDataSource_natInstance(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -0,0 +1,94 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsCloudwatchLogGroups() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsCloudwatchLogGroupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"log_group_name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"log_group_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"retention_in_days": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+	}
+}
+
+func dataSourceAwsCloudwatchLogGroupsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatchlogsconn
+
+	prefix := d.Get("log_group_name_prefix").(string)
+	input := &cloudwatchlogs.DescribeLogGroupsInput{}
+
+	if prefix != "" {
+		input.LogGroupNamePrefix = aws.String(prefix)
+	}
+
+	arns := make(map[string]interface{})
+	retentionInDays := make(map[string]interface{})
+	var names []string
+
+	log.Printf("[DEBUG] Reading CloudWatch Log Groups: %s", input)
+	err := conn.DescribeLogGroupsPages(input, func(page *cloudwatchlogs.DescribeLogGroupsOutput, lastPage bool) bool {
+		for _, logGroup := range page.LogGroups {
+			if logGroup == nil {
+				continue
+			}
+
+			name := aws.StringValue(logGroup.LogGroupName)
+			names = append(names, name)
+			arns[name] = aws.StringValue(logGroup.Arn)
+			// Log groups that never expire have no retention setting
+			retentionInDays[name] = int(aws.Int64Value(logGroup.RetentionInDays))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudWatch Log Groups (%s): %s", prefix, err)
+	}
+
+	sort.Strings(names)
+
+	d.SetId(time.Now().UTC().String())
+
+	if err := d.Set("arns", arns); err != nil {
+		return fmt.Errorf("error setting arns: %s", err)
+	}
+
+	if err := d.Set("log_group_names", names); err != nil {
+		return fmt.Errorf("error setting log_group_names: %s", err)
+	}
+
+	if err := d.Set("retention_in_days", retentionInDays); err != nil {
+		return fmt.Errorf("error setting retention_in_days: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSCloudwatchLogGroupsDataSource_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_cloudwatch_log_groups.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAWSCloudwatchLogGroupsDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "log_group_names.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "log_group_names.0", rName+"/1"),
+					resource.TestCheckResourceAttr(dataSourceName, "log_group_names.1", rName+"/2"),
+					resource.TestCheckResourceAttr(dataSourceName, "arns.%", "2"),
+					resource.TestCheckResourceAttrPair(dataSourceName, fmt.Sprintf("arns.%s/1", rName), "aws_cloudwatch_log_group.test1", "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "retention_in_days.%", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, fmt.Sprintf("retention_in_days.%s/1", rName), "7"),
+					resource.TestCheckResourceAttr(dataSourceName, fmt.Sprintf("retention_in_days.%s/2", rName), "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCloudwatchLogGroupsDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test1" {
+  name              = "%[1]s/1"
+  retention_in_days = 7
+}
+
+resource "aws_cloudwatch_log_group" "test2" {
+  name = "%[1]s/2"
+}
+
+data "aws_cloudwatch_log_groups" "test" {
+  log_group_name_prefix = "%[1]s/"
+
+  depends_on = ["aws_cloudwatch_log_group.test1", "aws_cloudwatch_log_group.test2"]
+}
+`, rName)
+}
@@ -114,27 +114,64 @@ func dataSourceAwsEbsSnapshotRead(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	log.Printf("[DEBUG] Reading EBS Snapshot: %s", params)
-	resp, err := conn.DescribeSnapshots(params)
+	snapshots, err := describeEbsSnapshots(conn, params)
 	if err != nil {
-		return err
+		return fmt.Errorf("error reading EBS Snapshots: %s", err)
 	}
 
-	if len(resp.Snapshots) < 1 {
+	if len(snapshots) < 1 {
 		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
 	}
 
-	if len(resp.Snapshots) > 1 {
+	if len(snapshots) > 1 {
 		if !d.Get("most_recent").(bool) {
 			return fmt.Errorf("Your query returned more than one result. Please try a more " +
 				"specific search criteria, or set `most_recent` attribute to true.")
 		}
-		sort.Slice(resp.Snapshots, func(i, j int) bool {
-			return aws.TimeValue(resp.Snapshots[i].StartTime).Unix() > aws.TimeValue(resp.Snapshots[j].StartTime).Unix()
+		sort.Slice(snapshots, func(i, j int) bool {
+			return ebsSnapshotStartedAfter(snapshots[i], snapshots[j])
 		})
 	}
 
 	//Single Snapshot found so set to state
-	return snapshotDescriptionAttributes(d, resp.Snapshots[0])
+	return snapshotDescriptionAttributes(d, snapshots[0])
+}
+
+// describeEbsSnapshots returns all snapshots matching the input. Unless
+// specific snapshot IDs are requested, results are paginated, as an
+// unpaginated DescribeSnapshots call can time out in accounts with many
+// snapshots.
+func describeEbsSnapshots(conn *ec2.EC2, input *ec2.DescribeSnapshotsInput) ([]*ec2.Snapshot, error) {
+	// MaxResults and SnapshotIds cannot be specified in the same request.
+	if len(input.SnapshotIds) == 0 {
+		paginatedInput := *input
+		paginatedInput.MaxResults = aws.Int64(1000)
+		input = &paginatedInput
+	}
+
+	var snapshots []*ec2.Snapshot
+
+	err := conn.DescribeSnapshotsPages(input, func(page *ec2.DescribeSnapshotsOutput, lastPage bool) bool {
+		snapshots = append(snapshots, page.Snapshots...)
+
+		return !lastPage
+	})
+
+	return snapshots, err
+}
+
+// ebsSnapshotStartedAfter reports whether snapshot a was started after
+// snapshot b. Snapshots with the same start time are ordered by snapshot ID
+// so that most_recent and sorted results do not change between refreshes.
+func ebsSnapshotStartedAfter(a, b *ec2.Snapshot) bool {
+	aTime := aws.TimeValue(a.StartTime)
+	bTime := aws.TimeValue(b.StartTime)
+
+	if !aTime.Equal(bTime) {
+		return aTime.After(bTime)
+	}
+
+	return aws.StringValue(a.SnapshotId) > aws.StringValue(b.SnapshotId)
 }
 
 func snapshotDescriptionAttributes(d *schema.ResourceData, snapshot *ec2.Snapshot) error {
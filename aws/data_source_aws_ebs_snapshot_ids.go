@@ -5,7 +5,6 @@ import (
 	"log"
 	"sort"
 
-	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -62,17 +61,17 @@ func dataSourceAwsEbsSnapshotIdsRead(d *schema.ResourceData, meta interface{}) e
 	}
 
 	log.Printf("[DEBUG] Reading EBS Snapshot IDs: %s", params)
-	resp, err := conn.DescribeSnapshots(params)
+	snapshots, err := describeEbsSnapshots(conn, params)
 	if err != nil {
-		return err
+		return fmt.Errorf("error reading EBS Snapshots: %s", err)
 	}
 
 	snapshotIds := make([]string, 0)
 
-	sort.Slice(resp.Snapshots, func(i, j int) bool {
-		return aws.TimeValue(resp.Snapshots[i].StartTime).Unix() > aws.TimeValue(resp.Snapshots[j].StartTime).Unix()
+	sort.Slice(snapshots, func(i, j int) bool {
+		return ebsSnapshotStartedAfter(snapshots[i], snapshots[j])
 	})
-	for _, snapshot := range resp.Snapshots {
+	for _, snapshot := range snapshots {
 		snapshotIds = append(snapshotIds, *snapshot.SnapshotId)
 	}
 
@@ -3,11 +3,58 @@ package aws
 import (
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func TestEbsSnapshotStartedAfter(t *testing.T) {
+	startTime := time.Date(2019, time.May, 1, 10, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name string
+		a    *ec2.Snapshot
+		b    *ec2.Snapshot
+		want bool
+	}{
+		{
+			name: "newer",
+			a:    &ec2.Snapshot{StartTime: aws.Time(startTime.Add(time.Hour)), SnapshotId: aws.String("snap-11111111")},
+			b:    &ec2.Snapshot{StartTime: aws.Time(startTime), SnapshotId: aws.String("snap-22222222")},
+			want: true,
+		},
+		{
+			name: "sub-second difference",
+			a:    &ec2.Snapshot{StartTime: aws.Time(startTime), SnapshotId: aws.String("snap-22222222")},
+			b:    &ec2.Snapshot{StartTime: aws.Time(startTime.Add(500 * time.Millisecond)), SnapshotId: aws.String("snap-11111111")},
+			want: false,
+		},
+		{
+			name: "same start time higher ID",
+			a:    &ec2.Snapshot{StartTime: aws.Time(startTime), SnapshotId: aws.String("snap-22222222")},
+			b:    &ec2.Snapshot{StartTime: aws.Time(startTime), SnapshotId: aws.String("snap-11111111")},
+			want: true,
+		},
+		{
+			name: "same start time lower ID",
+			a:    &ec2.Snapshot{StartTime: aws.Time(startTime), SnapshotId: aws.String("snap-11111111")},
+			b:    &ec2.Snapshot{StartTime: aws.Time(startTime), SnapshotId: aws.String("snap-22222222")},
+			want: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if got := ebsSnapshotStartedAfter(testCase.a, testCase.b); got != testCase.want {
+				t.Errorf("got %t, expected %t", got, testCase.want)
+			}
+		})
+	}
+}
+
 func TestAccAWSEbsSnapshotDataSource_basic(t *testing.T) {
 	dataSourceName := "data.aws_ebs_snapshot.test"
 	resourceName := "aws_ebs_snapshot.test"
@@ -0,0 +1,100 @@
+package aws
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceAwsElasticacheReservedCacheNodeOffering() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsElasticacheReservedCacheNodeOfferingRead,
+
+		Schema: map[string]*schema.Schema{
+			"cache_node_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"duration": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntInSlice([]int{31536000, 94608000}),
+			},
+			"fixed_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"offering_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"offering_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"All Upfront",
+					"Heavy Utilization",
+					"Light Utilization",
+					"Medium Utilization",
+					"No Upfront",
+					"Partial Upfront",
+				}, false),
+			},
+			"product_description": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsElasticacheReservedCacheNodeOfferingRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elasticacheconn
+
+	input := &elasticache.DescribeReservedCacheNodesOfferingsInput{
+		CacheNodeType:      aws.String(d.Get("cache_node_type").(string)),
+		Duration:           aws.String(strconv.Itoa(d.Get("duration").(int))),
+		OfferingType:       aws.String(d.Get("offering_type").(string)),
+		ProductDescription: aws.String(d.Get("product_description").(string)),
+	}
+
+	var offerings []*elasticache.ReservedCacheNodesOffering
+
+	err := conn.DescribeReservedCacheNodesOfferingsPages(input, func(page *elasticache.DescribeReservedCacheNodesOfferingsOutput, lastPage bool) bool {
+		for _, offering := range page.ReservedCacheNodesOfferings {
+			// ProductDescription is matched as a prefix by the API.
+			if aws.StringValue(offering.ProductDescription) == d.Get("product_description").(string) {
+				offerings = append(offerings, offering)
+			}
+		}
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading ElastiCache Reserved Cache Node Offerings: %s", err)
+	}
+
+	if len(offerings) == 0 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	if len(offerings) > 1 {
+		return fmt.Errorf("Your query returned more than one result. Please try a more specific search criteria.")
+	}
+
+	offering := offerings[0]
+
+	d.SetId(aws.StringValue(offering.ReservedCacheNodesOfferingId))
+	d.Set("cache_node_type", offering.CacheNodeType)
+	d.Set("duration", offering.Duration)
+	d.Set("fixed_price", offering.FixedPrice)
+	d.Set("offering_id", offering.ReservedCacheNodesOfferingId)
+	d.Set("offering_type", offering.OfferingType)
+	d.Set("product_description", offering.ProductDescription)
+
+	return nil
+}
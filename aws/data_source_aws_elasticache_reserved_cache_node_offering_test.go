@@ -0,0 +1,38 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSElasticacheReservedCacheNodeOfferingDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_elasticache_reserved_cache_node_offering.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSElasticacheReservedCacheNodeOfferingDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "cache_node_type", "cache.t2.micro"),
+					resource.TestCheckResourceAttr(dataSourceName, "duration", "31536000"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "fixed_price"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "offering_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "offering_type", "All Upfront"),
+					resource.TestCheckResourceAttr(dataSourceName, "product_description", "redis"),
+				),
+			},
+		},
+	})
+}
+
+const testAccAWSElasticacheReservedCacheNodeOfferingDataSourceConfig_basic = `
+data "aws_elasticache_reserved_cache_node_offering" "test" {
+  cache_node_type     = "cache.t2.micro"
+  duration            = 31536000
+  offering_type       = "All Upfront"
+  product_description = "redis"
+}
+`
@@ -2,6 +2,7 @@ package aws
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"log"
 	"time"
@@ -61,9 +62,24 @@ func dataSourceAwsKmsSecretsRead(d *schema.ResourceData, meta interface{}) error
 	secrets := d.Get("secret").(*schema.Set)
 	plaintext := make(map[string]string, len(secrets.List()))
 
+	// KMS has no batch decryption API, so only decrypt each distinct
+	// payload, encryption context and grant tokens combination once
+	decrypted := make(map[string]string)
+
 	for _, v := range secrets.List() {
 		secret := v.(map[string]interface{})
 
+		cacheKey, err := dataSourceAwsKmsSecretsCacheKey(secret)
+		if err != nil {
+			return fmt.Errorf("error building cache key for secret '%s': %s", secret["name"].(string), err)
+		}
+
+		if value, ok := decrypted[cacheKey]; ok {
+			log.Printf("[DEBUG] aws_kms_secrets - reusing decrypted payload for secret: %s", secret["name"].(string))
+			plaintext[secret["name"].(string)] = value
+			continue
+		}
+
 		// base64 decode the payload
 		payload, err := base64.StdEncoding.DecodeString(secret["payload"].(string))
 		if err != nil {
@@ -96,6 +112,7 @@ func dataSourceAwsKmsSecretsRead(d *schema.ResourceData, meta interface{}) error
 		// Set the secret via the name
 		log.Printf("[DEBUG] aws_kms_secret - successfully decrypted secret: %s", secret["name"].(string))
 		plaintext[secret["name"].(string)] = string(resp.Plaintext)
+		decrypted[cacheKey] = string(resp.Plaintext)
 	}
 
 	if err := d.Set("plaintext", plaintext); err != nil {
@@ -106,3 +123,31 @@ func dataSourceAwsKmsSecretsRead(d *schema.ResourceData, meta interface{}) error
 
 	return nil
 }
+
+// dataSourceAwsKmsSecretsCacheKey identifies a secret by its payload, encryption context and
+// grant tokens. The payload and context determine the plaintext, and the grant tokens determine
+// whether the secret may be decrypted at all, so plaintext is never shared across grants.
+func dataSourceAwsKmsSecretsCacheKey(secret map[string]interface{}) (string, error) {
+	context := make(map[string]interface{})
+
+	if v, ok := secret["context"].(map[string]interface{}); ok {
+		context = v
+	}
+
+	grantTokens := make([]interface{}, 0)
+
+	if v, ok := secret["grant_tokens"].([]interface{}); ok {
+		grantTokens = v
+	}
+
+	// Map keys are marshalled in sorted order
+	b, err := json.Marshal(map[string]interface{}{
+		"context":      context,
+		"grant_tokens": grantTokens,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s:%s", secret["payload"].(string), b), nil
+}
@@ -37,6 +37,31 @@ func TestAccAWSKmsSecretsDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSKmsSecretsDataSource_DuplicatePayload(t *testing.T) {
+	var encryptedPayload string
+	var key kms.KeyMetadata
+
+	plaintext := "my-plaintext-string"
+	resourceName := "aws_kms_key.test"
+
+	// Run a resource test to setup our KMS key
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAwsKmsSecretsDataSourceKey,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsKeyExists(resourceName, &key),
+					testAccDataSourceAwsKmsSecretsEncrypt(&key, plaintext, &encryptedPayload),
+					// We need to dereference the encryptedPayload in a test Terraform configuration
+					testAccDataSourceAwsKmsSecretsDecryptDuplicatePayload(t, plaintext, &encryptedPayload),
+				),
+			},
+		},
+	})
+}
+
 func testAccDataSourceAwsKmsSecretsEncrypt(key *kms.KeyMetadata, plaintext string, encryptedPayload *string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		kmsconn := testAccProvider.Meta().(*AWSClient).kmsconn
@@ -82,6 +107,29 @@ func testAccDataSourceAwsKmsSecretsDecrypt(t *testing.T, plaintext string, encry
 	}
 }
 
+func testAccDataSourceAwsKmsSecretsDecryptDuplicatePayload(t *testing.T, plaintext string, encryptedPayload *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		dataSourceName := "data.aws_kms_secrets.test"
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:  func() { testAccPreCheck(t) },
+			Providers: testAccProviders,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccCheckAwsKmsSecretsDataSourceSecretDuplicatePayload(*encryptedPayload),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(dataSourceName, "plaintext.%", "2"),
+						resource.TestCheckResourceAttr(dataSourceName, "plaintext.secret1", plaintext),
+						resource.TestCheckResourceAttr(dataSourceName, "plaintext.secret2", plaintext),
+					),
+				},
+			},
+		})
+
+		return nil
+	}
+}
+
 const testAccCheckAwsKmsSecretsDataSourceKey = `
 resource "aws_kms_key" "test" {
   deletion_window_in_days = 7
@@ -103,3 +151,135 @@ data "aws_kms_secrets" "test" {
 }
 `, payload)
 }
+
+func testAccCheckAwsKmsSecretsDataSourceSecretDuplicatePayload(payload string) string {
+	return testAccCheckAwsKmsSecretsDataSourceKey + fmt.Sprintf(`
+data "aws_kms_secrets" "test" {
+  secret {
+    name    = "secret1"
+    payload = %[1]q
+
+    context = {
+      name = "value"
+    }
+  }
+
+  secret {
+    name    = "secret2"
+    payload = %[1]q
+
+    context = {
+      name = "value"
+    }
+  }
+}
+`, payload)
+}
+
+func TestDataSourceAwsKmsSecretsCacheKey(t *testing.T) {
+	testCases := []struct {
+		name    string
+		secret1 map[string]interface{}
+		secret2 map[string]interface{}
+		equal   bool
+	}{
+		{
+			name: "same payload no context",
+			secret1: map[string]interface{}{
+				"payload": "payload1",
+			},
+			secret2: map[string]interface{}{
+				"payload": "payload1",
+				"context": map[string]interface{}{},
+			},
+			equal: true,
+		},
+		{
+			name: "same payload same context",
+			secret1: map[string]interface{}{
+				"payload": "payload1",
+				"context": map[string]interface{}{"a": "1", "b": "2"},
+			},
+			secret2: map[string]interface{}{
+				"payload": "payload1",
+				"context": map[string]interface{}{"b": "2", "a": "1"},
+			},
+			equal: true,
+		},
+		{
+			name: "same payload different context",
+			secret1: map[string]interface{}{
+				"payload": "payload1",
+				"context": map[string]interface{}{"a": "1"},
+			},
+			secret2: map[string]interface{}{
+				"payload": "payload1",
+				"context": map[string]interface{}{"a": "2"},
+			},
+			equal: false,
+		},
+		{
+			name: "same payload same grant tokens",
+			secret1: map[string]interface{}{
+				"payload":      "payload1",
+				"grant_tokens": []interface{}{"token1"},
+			},
+			secret2: map[string]interface{}{
+				"payload":      "payload1",
+				"grant_tokens": []interface{}{"token1"},
+			},
+			equal: true,
+		},
+		{
+			name: "same payload with and without grant tokens",
+			secret1: map[string]interface{}{
+				"payload": "payload1",
+			},
+			secret2: map[string]interface{}{
+				"payload":      "payload1",
+				"grant_tokens": []interface{}{"token1"},
+			},
+			equal: false,
+		},
+		{
+			name: "same payload different grant tokens",
+			secret1: map[string]interface{}{
+				"payload":      "payload1",
+				"grant_tokens": []interface{}{"token1"},
+			},
+			secret2: map[string]interface{}{
+				"payload":      "payload1",
+				"grant_tokens": []interface{}{"token2"},
+			},
+			equal: false,
+		},
+		{
+			name: "different payload",
+			secret1: map[string]interface{}{
+				"payload": "payload1",
+			},
+			secret2: map[string]interface{}{
+				"payload": "payload2",
+			},
+			equal: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			key1, err := dataSourceAwsKmsSecretsCacheKey(testCase.secret1)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			key2, err := dataSourceAwsKmsSecretsCacheKey(testCase.secret2)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := key1 == key2; got != testCase.equal {
+				t.Errorf("got keys %q and %q equal: %t, expected %t", key1, key2, got, testCase.equal)
+			}
+		})
+	}
+}
@@ -68,21 +68,23 @@ func dataSourceAwsNetworkAclsRead(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	log.Printf("[DEBUG] DescribeNetworkAcls %s\n", req)
-	resp, err := conn.DescribeNetworkAcls(req)
+	networkAcls := make([]string, 0)
+
+	err := conn.DescribeNetworkAclsPages(req, func(page *ec2.DescribeNetworkAclsOutput, lastPage bool) bool {
+		for _, networkAcl := range page.NetworkAcls {
+			networkAcls = append(networkAcls, aws.StringValue(networkAcl.NetworkAclId))
+		}
+
+		return !lastPage
+	})
 	if err != nil {
 		return err
 	}
 
-	if resp == nil || len(resp.NetworkAcls) == 0 {
+	if len(networkAcls) == 0 {
 		return errors.New("no matching network ACLs found")
 	}
 
-	networkAcls := make([]string, 0)
-
-	for _, networkAcl := range resp.NetworkAcls {
-		networkAcls = append(networkAcls, aws.StringValue(networkAcl.NetworkAclId))
-	}
-
 	d.SetId(resource.UniqueId())
 	if err := d.Set("ids", networkAcls); err != nil {
 		return fmt.Errorf("Error setting network ACL ids: %s", err)
@@ -55,21 +55,23 @@ func dataSourceAwsNetworkInterfacesRead(d *schema.ResourceData, meta interface{}
 	}
 
 	log.Printf("[DEBUG] DescribeNetworkInterfaces %s\n", req)
-	resp, err := conn.DescribeNetworkInterfaces(req)
+	networkInterfaces := make([]string, 0)
+
+	err := conn.DescribeNetworkInterfacesPages(req, func(page *ec2.DescribeNetworkInterfacesOutput, lastPage bool) bool {
+		for _, networkInterface := range page.NetworkInterfaces {
+			networkInterfaces = append(networkInterfaces, aws.StringValue(networkInterface.NetworkInterfaceId))
+		}
+
+		return !lastPage
+	})
 	if err != nil {
 		return err
 	}
 
-	if resp == nil || len(resp.NetworkInterfaces) == 0 {
+	if len(networkInterfaces) == 0 {
 		return errors.New("no matching network interfaces found")
 	}
 
-	networkInterfaces := make([]string, 0)
-
-	for _, networkInterface := range resp.NetworkInterfaces {
-		networkInterfaces = append(networkInterfaces, aws.StringValue(networkInterface.NetworkInterfaceId))
-	}
-
 	d.SetId(resource.UniqueId())
 	if err := d.Set("ids", networkInterfaces); err != nil {
 		return fmt.Errorf("Error setting network interfaces ids: %s", err)
@@ -0,0 +1,132 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceAwsRamResourceShareInvitations() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsRamResourceShareInvitationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"invitations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"invitation_timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"receiver_account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_share_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_share_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sender_account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"resource_share_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArn,
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ram.ResourceShareInvitationStatusAccepted,
+					ram.ResourceShareInvitationStatusExpired,
+					ram.ResourceShareInvitationStatusPending,
+					ram.ResourceShareInvitationStatusRejected,
+				}, false),
+			},
+		},
+	}
+}
+
+func dataSourceAwsRamResourceShareInvitationsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ramconn
+
+	input := &ram.GetResourceShareInvitationsInput{}
+
+	if v, ok := d.GetOk("resource_share_arns"); ok && v.(*schema.Set).Len() > 0 {
+		input.ResourceShareArns = expandStringSet(v.(*schema.Set))
+	}
+
+	status := d.Get("status").(string)
+	var invitations []interface{}
+
+	log.Printf("[DEBUG] Reading RAM Resource Share invitations: %s", input)
+	err := conn.GetResourceShareInvitationsPages(input, func(page *ram.GetResourceShareInvitationsOutput, lastPage bool) bool {
+		for _, invitation := range page.ResourceShareInvitations {
+			if invitation == nil {
+				continue
+			}
+
+			// The API does not filter by status
+			if status != "" && aws.StringValue(invitation.Status) != status {
+				continue
+			}
+
+			m := map[string]interface{}{
+				"arn":                  aws.StringValue(invitation.ResourceShareInvitationArn),
+				"invitation_timestamp": "",
+				"receiver_account_id":  aws.StringValue(invitation.ReceiverAccountId),
+				"resource_share_arn":   aws.StringValue(invitation.ResourceShareArn),
+				"resource_share_name":  aws.StringValue(invitation.ResourceShareName),
+				"sender_account_id":    aws.StringValue(invitation.SenderAccountId),
+				"status":               aws.StringValue(invitation.Status),
+			}
+
+			if invitation.InvitationTimestamp != nil {
+				m["invitation_timestamp"] = aws.TimeValue(invitation.InvitationTimestamp).Format(time.RFC3339)
+			}
+
+			invitations = append(invitations, m)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading RAM Resource Share invitations: %s", err)
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	if err := d.Set("invitations", invitations); err != nil {
+		return fmt.Errorf("error setting invitations: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestAccDataSourceAwsRamResourceShareInvitations_basic(t *testing.T) {
+	var providers []*schema.Provider
+	dataSourceName := "data.aws_ram_resource_share_invitations.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsRamResourceShareInvitationsConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "invitations.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "invitations.0.arn"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "invitations.0.invitation_timestamp"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "invitations.0.receiver_account_id", "data.aws_caller_identity.receiver", "account_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "invitations.0.resource_share_arn", "aws_ram_resource_share.test", "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "invitations.0.resource_share_name", rName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "invitations.0.sender_account_id", "data.aws_caller_identity.sender", "account_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "invitations.0.status", "PENDING"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsRamResourceShareInvitationsConfig(rName string) string {
+	return testAccAlternateAccountProviderConfig() + fmt.Sprintf(`
+data "aws_caller_identity" "receiver" {}
+
+data "aws_caller_identity" "sender" {
+  provider = "aws.alternate"
+}
+
+resource "aws_ram_resource_share" "test" {
+  provider = "aws.alternate"
+
+  name                      = %[1]q
+  allow_external_principals = true
+}
+
+resource "aws_ram_principal_association" "test" {
+  provider = "aws.alternate"
+
+  principal          = "${data.aws_caller_identity.receiver.account_id}"
+  resource_share_arn = "${aws_ram_resource_share.test.arn}"
+}
+
+data "aws_ram_resource_share_invitations" "test" {
+  resource_share_arns = ["${aws_ram_principal_association.test.resource_share_arn}"]
+  status              = "PENDING"
+}
+`, rName)
+}
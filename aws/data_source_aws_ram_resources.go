@@ -0,0 +1,121 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceAwsRamResources() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsRamResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"principal": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resource_owner": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ram.ResourceOwnerOtherAccounts,
+					ram.ResourceOwnerSelf,
+				}, false),
+			},
+			"resource_share_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArn,
+				},
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_share_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsRamResourcesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ramconn
+
+	input := &ram.ListResourcesInput{
+		ResourceOwner: aws.String(d.Get("resource_owner").(string)),
+	}
+
+	if v, ok := d.GetOk("principal"); ok {
+		input.Principal = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("resource_share_arns"); ok && v.(*schema.Set).Len() > 0 {
+		input.ResourceShareArns = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("resource_type"); ok {
+		input.ResourceType = aws.String(v.(string))
+	}
+
+	var resources []interface{}
+
+	log.Printf("[DEBUG] Listing RAM resources: %s", input)
+	err := conn.ListResourcesPages(input, func(page *ram.ListResourcesOutput, lastPage bool) bool {
+		for _, r := range page.Resources {
+			if r == nil {
+				continue
+			}
+
+			resources = append(resources, map[string]interface{}{
+				"arn":                aws.StringValue(r.Arn),
+				"resource_share_arn": aws.StringValue(r.ResourceShareArn),
+				"status":             aws.StringValue(r.Status),
+				"type":               aws.StringValue(r.Type),
+			})
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing RAM resources: %s", err)
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	if err := d.Set("resources", resources); err != nil {
+		return fmt.Errorf("error setting resources: %s", err)
+	}
+
+	return nil
+}
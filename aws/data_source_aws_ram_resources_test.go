@@ -0,0 +1,55 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsRamResources_basic(t *testing.T) {
+	dataSourceName := "data.aws_ram_resources.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsRamResourcesConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resources.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resources.0.arn", "aws_ec2_transit_gateway.test", "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resources.0.resource_share_arn", "aws_ram_resource_share.test", "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.status", "AVAILABLE"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.type", "ec2:TransitGateway"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsRamResourcesConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ram_resource_share" "test" {
+  name = %[1]q
+}
+
+resource "aws_ram_resource_association" "test" {
+  resource_arn       = "${aws_ec2_transit_gateway.test.arn}"
+  resource_share_arn = "${aws_ram_resource_share.test.arn}"
+}
+
+data "aws_ram_resources" "test" {
+  resource_owner      = "SELF"
+  resource_share_arns = ["${aws_ram_resource_association.test.resource_share_arn}"]
+}
+`, rName)
+}
@@ -0,0 +1,92 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroups"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsResourceGroupsGroupResources() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsResourceGroupsGroupResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"resource_type_filters": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsResourceGroupsGroupResourcesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).resourcegroupsconn
+
+	groupName := d.Get("group_name").(string)
+	input := &resourcegroups.ListGroupResourcesInput{
+		GroupName: aws.String(groupName),
+	}
+
+	if v, ok := d.GetOk("resource_type_filters"); ok && v.(*schema.Set).Len() > 0 {
+		input.Filters = []*resourcegroups.ResourceFilter{
+			{
+				Name:   aws.String(resourcegroups.ResourceFilterNameResourceType),
+				Values: expandStringSet(v.(*schema.Set)),
+			},
+		}
+	}
+
+	var resources []interface{}
+
+	log.Printf("[DEBUG] Listing Resource Groups Group resources: %s", input)
+	err := conn.ListGroupResourcesPages(input, func(page *resourcegroups.ListGroupResourcesOutput, lastPage bool) bool {
+		for _, identifier := range page.ResourceIdentifiers {
+			if identifier == nil {
+				continue
+			}
+
+			resources = append(resources, map[string]interface{}{
+				"arn":           aws.StringValue(identifier.ResourceArn),
+				"resource_type": aws.StringValue(identifier.ResourceType),
+			})
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing Resource Groups Group (%s) resources: %s", groupName, err)
+	}
+
+	d.SetId(groupName)
+
+	if err := d.Set("resources", resources); err != nil {
+		return fmt.Errorf("error setting resources: %s", err)
+	}
+
+	return nil
+}
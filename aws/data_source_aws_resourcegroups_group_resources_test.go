@@ -0,0 +1,69 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSResourceGroupsGroupResourcesDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_resourcegroups_group_resources.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSResourceGroupsGroupResourcesDataSourceConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "group_name", "aws_resourcegroups_group.test", "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resources.0.arn", "aws_vpc.test", "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.resource_type", "AWS::EC2::VPC"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSResourceGroupsGroupResourcesDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_resourcegroups_group" "test" {
+  name = %[1]q
+
+  resource_query {
+    query = <<JSON
+{
+  "ResourceTypeFilters": [
+    "AWS::EC2::VPC"
+  ],
+  "TagFilters": [
+    {
+      "Key": "Name",
+      "Values": [%[1]q]
+    }
+  ]
+}
+JSON
+  }
+}
+
+data "aws_resourcegroups_group_resources" "test" {
+  group_name            = "${aws_resourcegroups_group.test.name}"
+  resource_type_filters = ["AWS::EC2::VPC"]
+
+  depends_on = ["aws_vpc.test"]
+}
+`, rName)
+}
@@ -56,21 +56,23 @@ func dataSourceAwsRouteTablesRead(d *schema.ResourceData, meta interface{}) erro
 	)...)
 
 	log.Printf("[DEBUG] DescribeRouteTables %s\n", req)
-	resp, err := conn.DescribeRouteTables(req)
+	routeTables := make([]string, 0)
+
+	err := conn.DescribeRouteTablesPages(req, func(page *ec2.DescribeRouteTablesOutput, lastPage bool) bool {
+		for _, routeTable := range page.RouteTables {
+			routeTables = append(routeTables, aws.StringValue(routeTable.RouteTableId))
+		}
+
+		return !lastPage
+	})
 	if err != nil {
 		return err
 	}
 
-	if resp == nil || len(resp.RouteTables) == 0 {
+	if len(routeTables) == 0 {
 		return fmt.Errorf("no matching route tables found for vpc with id %s", d.Get("vpc_id").(string))
 	}
 
-	routeTables := make([]string, 0)
-
-	for _, routeTable := range resp.RouteTables {
-		routeTables = append(routeTables, aws.StringValue(routeTable.RouteTableId))
-	}
-
 	d.SetId(resource.UniqueId())
 	if err = d.Set("ids", routeTables); err != nil {
 		return fmt.Errorf("error setting ids: %s", err)
@@ -0,0 +1,118 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsS3AccountPublicAccessBlock() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsS3AccountPublicAccessBlockRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"bucket": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"block_public_acls": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"block_public_policy": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"ignore_public_acls": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"restrict_public_buckets": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsS3AccountPublicAccessBlockRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3controlconn
+
+	accountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	input := &s3control.GetPublicAccessBlockInput{
+		AccountId: aws.String(accountID),
+	}
+
+	log.Printf("[DEBUG] Reading S3 Account Public Access Block: %s", input)
+	output, err := conn.GetPublicAccessBlock(input)
+
+	// A missing configuration is equivalent to all settings being disabled
+	configuration := &s3control.PublicAccessBlockConfiguration{}
+
+	if err != nil && !isAWSErr(err, s3control.ErrCodeNoSuchPublicAccessBlockConfiguration, "") {
+		return fmt.Errorf("error reading S3 Account Public Access Block (%s): %s", accountID, err)
+	}
+
+	if err == nil && output != nil && output.PublicAccessBlockConfiguration != nil {
+		configuration = output.PublicAccessBlockConfiguration
+	}
+
+	blockPublicAcls := aws.BoolValue(configuration.BlockPublicAcls)
+	blockPublicPolicy := aws.BoolValue(configuration.BlockPublicPolicy)
+	ignorePublicAcls := aws.BoolValue(configuration.IgnorePublicAcls)
+	restrictPublicBuckets := aws.BoolValue(configuration.RestrictPublicBuckets)
+
+	id := accountID
+
+	// The effective settings for a bucket are the most restrictive combination
+	// of the account-level and bucket-level configurations.
+	if v, ok := d.GetOk("bucket"); ok {
+		bucket := v.(string)
+		s3conn := meta.(*AWSClient).s3conn
+
+		bucketInput := &s3.GetPublicAccessBlockInput{
+			Bucket: aws.String(bucket),
+		}
+
+		log.Printf("[DEBUG] Reading S3 Bucket Public Access Block: %s", bucketInput)
+		bucketOutput, err := s3conn.GetPublicAccessBlock(bucketInput)
+
+		if err != nil && !isAWSErr(err, s3control.ErrCodeNoSuchPublicAccessBlockConfiguration, "") {
+			return fmt.Errorf("error reading S3 Bucket Public Access Block (%s): %s", bucket, err)
+		}
+
+		if err == nil && bucketOutput != nil && bucketOutput.PublicAccessBlockConfiguration != nil {
+			bucketConfiguration := bucketOutput.PublicAccessBlockConfiguration
+
+			blockPublicAcls = blockPublicAcls || aws.BoolValue(bucketConfiguration.BlockPublicAcls)
+			blockPublicPolicy = blockPublicPolicy || aws.BoolValue(bucketConfiguration.BlockPublicPolicy)
+			ignorePublicAcls = ignorePublicAcls || aws.BoolValue(bucketConfiguration.IgnorePublicAcls)
+			restrictPublicBuckets = restrictPublicBuckets || aws.BoolValue(bucketConfiguration.RestrictPublicBuckets)
+		}
+
+		id = fmt.Sprintf("%s:%s", accountID, bucket)
+	}
+
+	d.SetId(id)
+	d.Set("account_id", accountID)
+	d.Set("block_public_acls", blockPublicAcls)
+	d.Set("block_public_policy", blockPublicPolicy)
+	d.Set("ignore_public_acls", ignorePublicAcls)
+	d.Set("restrict_public_buckets", restrictPublicBuckets)
+
+	return nil
+}
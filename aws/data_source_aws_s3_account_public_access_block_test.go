@@ -0,0 +1,89 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func testAccDataSourceAWSS3AccountPublicAccessBlock_basic(t *testing.T) {
+	resourceName := "aws_s3_account_public_access_block.test"
+	dataSourceName := "data.aws_s3_account_public_access_block.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3AccountPublicAccessBlockDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAWSS3AccountPublicAccessBlockConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "account_id", resourceName, "account_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "block_public_acls", resourceName, "block_public_acls"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "block_public_policy", resourceName, "block_public_policy"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ignore_public_acls", resourceName, "ignore_public_acls"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "restrict_public_buckets", resourceName, "restrict_public_buckets"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAWSS3AccountPublicAccessBlock_Bucket(t *testing.T) {
+	bucketName := fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt())
+	dataSourceName := "data.aws_s3_account_public_access_block.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3AccountPublicAccessBlockDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAWSS3AccountPublicAccessBlockConfigBucket(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceAttrAccountID(dataSourceName, "account_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "bucket", bucketName),
+					resource.TestCheckResourceAttr(dataSourceName, "block_public_acls", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "block_public_policy", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "ignore_public_acls", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "restrict_public_buckets", "false"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAWSS3AccountPublicAccessBlockConfig = `
+resource "aws_s3_account_public_access_block" "test" {
+  block_public_acls   = true
+  block_public_policy = true
+}
+
+data "aws_s3_account_public_access_block" "test" {
+  account_id = "${aws_s3_account_public_access_block.test.account_id}"
+}
+`
+
+func testAccDataSourceAWSS3AccountPublicAccessBlockConfigBucket(bucketName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_account_public_access_block" "test" {
+  block_public_acls = true
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_public_access_block" "test" {
+  bucket              = "${aws_s3_bucket.test.id}"
+  block_public_policy = true
+}
+
+data "aws_s3_account_public_access_block" "test" {
+  account_id = "${aws_s3_account_public_access_block.test.account_id}"
+  bucket     = "${aws_s3_bucket_public_access_block.test.bucket}"
+}
+`, bucketName)
+}
@@ -0,0 +1,166 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// s3BucketObjectsPageSize is the largest number of keys ListObjectsV2
+// returns in a single page.
+const s3BucketObjectsPageSize = 1000
+
+func dataSourceAwsS3BucketObjects() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsS3BucketObjectsRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"delimiter": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"encoding_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{s3.EncodingTypeUrl}, false),
+			},
+			"max_keys": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1000,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"start_after": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"fetch_owner": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"keys": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"common_prefixes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"owners": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAwsS3BucketObjectsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+	maxKeys := d.Get("max_keys").(int)
+
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+
+	if v, ok := d.GetOk("delimiter"); ok {
+		input.Delimiter = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("encoding_type"); ok {
+		input.EncodingType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("start_after"); ok {
+		input.StartAfter = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("fetch_owner"); ok {
+		input.FetchOwner = aws.Bool(v.(bool))
+	}
+
+	// Only keep the attributes that are exported rather than whole page
+	// results, and stop paginating as soon as max_keys is reached, so that
+	// listing a small subset of a large bucket stays cheap.
+	var keys, commonPrefixes, owners []string
+
+	// Each page (including common prefixes) counts towards max_keys.
+	remaining := maxKeys
+	input.MaxKeys = aws.Int64(int64(s3BucketObjectsPageMaxKeys(remaining)))
+
+	if remaining > 0 {
+		err := conn.ListObjectsV2Pages(input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+			for _, commonPrefix := range page.CommonPrefixes {
+				commonPrefixes = append(commonPrefixes, aws.StringValue(commonPrefix.Prefix))
+			}
+
+			for _, object := range page.Contents {
+				keys = append(keys, aws.StringValue(object.Key))
+
+				if object.Owner != nil {
+					owners = append(owners, aws.StringValue(object.Owner.ID))
+				}
+			}
+
+			remaining -= len(page.CommonPrefixes) + len(page.Contents)
+
+			if remaining <= 0 {
+				return false
+			}
+
+			// The paginator reuses the input for the next request.
+			input.MaxKeys = aws.Int64(int64(s3BucketObjectsPageMaxKeys(remaining)))
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return fmt.Errorf("error listing S3 Bucket (%s) Objects: %s", bucket, err)
+		}
+	}
+
+	d.SetId(resource.UniqueId())
+
+	if err := d.Set("keys", keys); err != nil {
+		return fmt.Errorf("error setting keys: %s", err)
+	}
+
+	if err := d.Set("common_prefixes", commonPrefixes); err != nil {
+		return fmt.Errorf("error setting common_prefixes: %s", err)
+	}
+
+	if err := d.Set("owners", owners); err != nil {
+		return fmt.Errorf("error setting owners: %s", err)
+	}
+
+	return nil
+}
+
+func s3BucketObjectsPageMaxKeys(remaining int) int {
+	if remaining > s3BucketObjectsPageSize {
+		return s3BucketObjectsPageSize
+	}
+
+	return remaining
+}
@@ -0,0 +1,131 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAWSS3BucketObjects_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_s3_bucket_objects.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSourceS3BucketObjectsConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "keys.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.0", "arch/navajo/north_window"),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.1", "arch/navajo/sand_dune"),
+					resource.TestCheckResourceAttr(dataSourceName, "common_prefixes.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAWSS3BucketObjects_Delimiter(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_s3_bucket_objects.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSourceS3BucketObjectsConfig_delimiter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "keys.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "common_prefixes.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "common_prefixes.0", "arch/courthouse_towers/"),
+					resource.TestCheckResourceAttr(dataSourceName, "common_prefixes.1", "arch/navajo/"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAWSS3BucketObjects_MaxKeysStartAfter(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_s3_bucket_objects.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSourceS3BucketObjectsConfig_maxKeysStartAfter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "keys.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.0", "arch/navajo/north_window"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSDataSourceS3BucketObjectsConfigResources(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_object" "object1" {
+  bucket  = "${aws_s3_bucket.test.id}"
+  key     = "arch/courthouse_towers/landscape"
+  content = "Delicate"
+}
+
+resource "aws_s3_bucket_object" "object2" {
+  bucket  = "${aws_s3_bucket.test.id}"
+  key     = "arch/navajo/north_window"
+  content = "Balanced"
+}
+
+resource "aws_s3_bucket_object" "object3" {
+  bucket  = "${aws_s3_bucket.test.id}"
+  key     = "arch/navajo/sand_dune"
+  content = "Double"
+}
+`, rName)
+}
+
+func testAccAWSDataSourceS3BucketObjectsConfig_basic(rName string) string {
+	return testAccAWSDataSourceS3BucketObjectsConfigResources(rName) + `
+data "aws_s3_bucket_objects" "test" {
+  bucket = "${aws_s3_bucket.test.id}"
+  prefix = "arch/navajo/"
+
+  depends_on = ["aws_s3_bucket_object.object1", "aws_s3_bucket_object.object2", "aws_s3_bucket_object.object3"]
+}
+`
+}
+
+func testAccAWSDataSourceS3BucketObjectsConfig_delimiter(rName string) string {
+	return testAccAWSDataSourceS3BucketObjectsConfigResources(rName) + `
+data "aws_s3_bucket_objects" "test" {
+  bucket    = "${aws_s3_bucket.test.id}"
+  prefix    = "arch/"
+  delimiter = "/"
+
+  depends_on = ["aws_s3_bucket_object.object1", "aws_s3_bucket_object.object2", "aws_s3_bucket_object.object3"]
+}
+`
+}
+
+func testAccAWSDataSourceS3BucketObjectsConfig_maxKeysStartAfter(rName string) string {
+	return testAccAWSDataSourceS3BucketObjectsConfigResources(rName) + `
+data "aws_s3_bucket_objects" "test" {
+  bucket      = "${aws_s3_bucket.test.id}"
+  max_keys    = 1
+  start_after = "arch/courthouse_towers/landscape"
+
+  depends_on = ["aws_s3_bucket_object.object1", "aws_s3_bucket_object.object2", "aws_s3_bucket_object.object3"]
+}
+`
+}
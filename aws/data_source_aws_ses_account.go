@@ -0,0 +1,56 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsSesAccount() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsSesAccountRead,
+
+		Schema: map[string]*schema.Schema{
+			"max_24_hour_send": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"max_send_rate": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"sending_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"sent_last_24_hours": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsSesAccountRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesConn
+
+	quota, err := conn.GetSendQuota(&ses.GetSendQuotaInput{})
+	if err != nil {
+		return fmt.Errorf("error reading SES account send quota: %s", err)
+	}
+
+	sending, err := conn.GetAccountSendingEnabled(&ses.GetAccountSendingEnabledInput{})
+	if err != nil {
+		return fmt.Errorf("error reading SES account sending status: %s", err)
+	}
+
+	d.SetId(meta.(*AWSClient).region)
+	d.Set("max_24_hour_send", aws.Float64Value(quota.Max24HourSend))
+	d.Set("max_send_rate", aws.Float64Value(quota.MaxSendRate))
+	d.Set("sending_enabled", aws.BoolValue(sending.Enabled))
+	d.Set("sent_last_24_hours", aws.Float64Value(quota.SentLast24Hours))
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSSESAccountDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_ses_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESAccountDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "max_24_hour_send"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "max_send_rate"),
+					resource.TestCheckResourceAttr(dataSourceName, "sending_enabled", "true"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "sent_last_24_hours"),
+				),
+			},
+		},
+	})
+}
+
+const testAccAWSSESAccountDataSourceConfig = `
+data "aws_ses_account" "test" {}
+`
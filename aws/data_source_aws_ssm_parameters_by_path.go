@@ -0,0 +1,117 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceAwsSsmParametersByPath() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsSsmParametersByPathRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^/`), "must begin with a forward slash (/)"),
+			},
+			"recursive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"types": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"values": {
+				Type:      schema.TypeMap,
+				Computed:  true,
+				Sensitive: true,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+			},
+			"with_decryption": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsSsmParametersByPathRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssmconn
+
+	path := d.Get("path").(string)
+	input := &ssm.GetParametersByPathInput{
+		Path:           aws.String(path),
+		Recursive:      aws.Bool(d.Get("recursive").(bool)),
+		WithDecryption: aws.Bool(d.Get("with_decryption").(bool)),
+	}
+
+	arns := make(map[string]interface{})
+	types := make(map[string]interface{})
+	values := make(map[string]interface{})
+	var names []string
+
+	log.Printf("[DEBUG] Reading SSM Parameters by path: %s", input)
+	err := conn.GetParametersByPathPages(input, func(page *ssm.GetParametersByPathOutput, lastPage bool) bool {
+		for _, parameter := range page.Parameters {
+			if parameter == nil {
+				continue
+			}
+
+			name := aws.StringValue(parameter.Name)
+			names = append(names, name)
+			arns[name] = aws.StringValue(parameter.ARN)
+			types[name] = aws.StringValue(parameter.Type)
+			values[name] = aws.StringValue(parameter.Value)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading SSM Parameters by path (%s): %s", path, err)
+	}
+
+	sort.Strings(names)
+
+	d.SetId(path)
+
+	if err := d.Set("arns", arns); err != nil {
+		return fmt.Errorf("error setting arns: %s", err)
+	}
+
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("error setting names: %s", err)
+	}
+
+	if err := d.Set("types", types); err != nil {
+		return fmt.Errorf("error setting types: %s", err)
+	}
+
+	if err := d.Set("values", values); err != nil {
+		return fmt.Errorf("error setting values: %s", err)
+	}
+
+	return nil
+}
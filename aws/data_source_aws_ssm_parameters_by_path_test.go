@@ -0,0 +1,79 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSSsmParametersByPathDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_ssm_parameters_by_path.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSsmParametersByPathDataSourceConfig(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "names.0", fmt.Sprintf("/%s/param-a", rName)),
+					resource.TestCheckResourceAttr(dataSourceName, "names.1", fmt.Sprintf("/%s/param-b", rName)),
+					resource.TestCheckResourceAttr(dataSourceName, "values.%", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, fmt.Sprintf("values./%s/param-a", rName), "TestValueA"),
+					resource.TestCheckResourceAttr(dataSourceName, fmt.Sprintf("values./%s/param-b", rName), "TestValueB"),
+					resource.TestCheckResourceAttr(dataSourceName, "types.%", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, fmt.Sprintf("types./%s/param-a", rName), "String"),
+					resource.TestCheckResourceAttr(dataSourceName, fmt.Sprintf("types./%s/param-b", rName), "SecureString"),
+					resource.TestCheckResourceAttrPair(dataSourceName, fmt.Sprintf("arns./%s/param-a", rName), "aws_ssm_parameter.a", "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "with_decryption", "true"),
+				),
+			},
+			{
+				Config: testAccAwsSsmParametersByPathDataSourceConfig(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "names.2", fmt.Sprintf("/%s/sub/param-c", rName)),
+					resource.TestCheckResourceAttr(dataSourceName, fmt.Sprintf("values./%s/sub/param-c", rName), "TestValueC"),
+					resource.TestCheckResourceAttr(dataSourceName, "recursive", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsSsmParametersByPathDataSourceConfig(rName string, recursive bool) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "a" {
+  name  = "/%[1]s/param-a"
+  type  = "String"
+  value = "TestValueA"
+}
+
+resource "aws_ssm_parameter" "b" {
+  name  = "/%[1]s/param-b"
+  type  = "SecureString"
+  value = "TestValueB"
+}
+
+resource "aws_ssm_parameter" "c" {
+  name  = "/%[1]s/sub/param-c"
+  type  = "String"
+  value = "TestValueC"
+}
+
+data "aws_ssm_parameters_by_path" "test" {
+  path      = "/%[1]s"
+  recursive = %[2]t
+
+  depends_on = [
+    "aws_ssm_parameter.a",
+    "aws_ssm_parameter.b",
+    "aws_ssm_parameter.c",
+  ]
+}
+`, rName, recursive)
+}
@@ -53,21 +53,23 @@ func dataSourceAwsVpcsRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Printf("[DEBUG] DescribeVpcs %s\n", req)
-	resp, err := conn.DescribeVpcs(req)
+	vpcs := make([]string, 0)
+
+	err := conn.DescribeVpcsPages(req, func(page *ec2.DescribeVpcsOutput, lastPage bool) bool {
+		for _, vpc := range page.Vpcs {
+			vpcs = append(vpcs, aws.StringValue(vpc.VpcId))
+		}
+
+		return !lastPage
+	})
 	if err != nil {
 		return err
 	}
 
-	if resp == nil || len(resp.Vpcs) == 0 {
+	if len(vpcs) == 0 {
 		return fmt.Errorf("no matching VPC found")
 	}
 
-	vpcs := make([]string, 0)
-
-	for _, vpc := range resp.Vpcs {
-		vpcs = append(vpcs, aws.StringValue(vpc.VpcId))
-	}
-
 	d.SetId(time.Now().UTC().String())
 	if err := d.Set("ids", vpcs); err != nil {
 		return fmt.Errorf("Error setting vpc ids: %s", err)
@@ -0,0 +1,55 @@
+package aws
+
+import (
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// defaultEventualConsistencyTimeout is used when the provider eventual_consistency_timeout
+// argument is not configured, e.g. for clients built outside of providerConfigure.
+const defaultEventualConsistencyTimeout = 2 * time.Minute
+
+// awsEventualConsistencyError matches an AWS error returned while a recently created
+// or modified dependency, such as an IAM role or KMS key, has not yet propagated.
+// An empty message matches any error with the code.
+type awsEventualConsistencyError struct {
+	code    string
+	message string
+}
+
+// retryOnAwsEventualConsistencyErrors calls f until it succeeds, returns an error
+// not matching any of the given eventual consistency errors, or the timeout elapses.
+// If the timeout elapses before any call to f completes, f is called once more.
+func retryOnAwsEventualConsistencyErrors(timeout time.Duration, f func() (interface{}, error), consistencyErrors ...awsEventualConsistencyError) (interface{}, error) {
+	if timeout <= 0 {
+		timeout = defaultEventualConsistencyTimeout
+	}
+
+	var output interface{}
+
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		var err error
+		output, err = f()
+
+		if err == nil {
+			return nil
+		}
+
+		for _, consistencyError := range consistencyErrors {
+			if isAWSErr(err, consistencyError.code, consistencyError.message) {
+				log.Printf("[DEBUG] Retrying on eventual consistency error: %s", err)
+				return resource.RetryableError(err)
+			}
+		}
+
+		return resource.NonRetryableError(err)
+	})
+
+	if isResourceTimeoutError(err) {
+		output, err = f()
+	}
+
+	return output, err
+}
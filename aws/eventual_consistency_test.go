@@ -0,0 +1,107 @@
+package aws
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+func TestRetryOnAwsEventualConsistencyErrors(t *testing.T) {
+	consistencyErrors := []awsEventualConsistencyError{
+		{code: "InvalidParameterValueException", message: "cannot be assumed"},
+		{code: "MalformedPolicyDocumentException"},
+	}
+
+	testCases := []struct {
+		name      string
+		errs      []error
+		wantCalls int
+		wantErr   bool
+	}{
+		{
+			name:      "success",
+			errs:      []error{nil},
+			wantCalls: 1,
+		},
+		{
+			name: "eventual consistency error with message",
+			errs: []error{
+				awserr.New("InvalidParameterValueException", "The role cannot be assumed", nil),
+				nil,
+			},
+			wantCalls: 2,
+		},
+		{
+			name: "eventual consistency error with any message",
+			errs: []error{
+				awserr.New("MalformedPolicyDocumentException", "Invalid principal", nil),
+				awserr.New("MalformedPolicyDocumentException", "Invalid principal", nil),
+				nil,
+			},
+			wantCalls: 3,
+		},
+		{
+			name: "other error code",
+			errs: []error{
+				awserr.New("ValidationException", "The role cannot be assumed", nil),
+			},
+			wantCalls: 1,
+			wantErr:   true,
+		},
+		{
+			name: "other error message",
+			errs: []error{
+				awserr.New("InvalidParameterValueException", "Invalid runtime", nil),
+			},
+			wantCalls: 1,
+			wantErr:   true,
+		},
+		{
+			name: "non-AWS error",
+			errs: []error{
+				errors.New("connection reset"),
+			},
+			wantCalls: 1,
+			wantErr:   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			calls := 0
+
+			output, err := retryOnAwsEventualConsistencyErrors(5*time.Second, func() (interface{}, error) {
+				err := testCase.errs[calls]
+				calls++
+
+				if err != nil {
+					return nil, err
+				}
+
+				return "output", nil
+			}, consistencyErrors...)
+
+			if calls != testCase.wantCalls {
+				t.Errorf("got %d calls, expected %d", calls, testCase.wantCalls)
+			}
+
+			if testCase.wantErr {
+				if err == nil {
+					t.Error("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if output != "output" {
+				t.Errorf("got output %v, expected %q", output, "output")
+			}
+		})
+	}
+}
@@ -0,0 +1,3 @@
+//go:generate go run internal/generators/serviceclients/main.go
+
+package aws
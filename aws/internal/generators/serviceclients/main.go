@@ -0,0 +1,337 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"text/template"
+)
+
+const filename = `service_clients_gen.go`
+
+// ServiceClient describes an AWSClient service client.
+type ServiceClient struct {
+	// EndpointName is the provider endpoints configuration block argument.
+	EndpointName string
+	// Field is the AWSClient struct field.
+	Field string
+	// Package is the AWS Go SDK service package.
+	Package string
+	// Region overrides the provider region for global services.
+	Region string
+	// ExtraConfig is additional aws.Config fields for the client.
+	ExtraConfig string
+}
+
+// DeprecatedEndpoint is an endpoints configuration block argument that
+// overrides the endpoint of an existing service client when set.
+type DeprecatedEndpoint struct {
+	EndpointName string
+	// Field is the AWSClient struct field of the overridden service client.
+	Field string
+}
+
+// serviceClients is the single list of service clients. Adding a client here
+// adds its endpoints configuration block argument, its AWSClient session
+// wiring and its service_limits validation.
+var serviceClients = []ServiceClient{
+	{EndpointName: "acm", Field: "acmconn", Package: "acm"},
+	{EndpointName: "acmpca", Field: "acmpcaconn", Package: "acmpca"},
+	{EndpointName: "apigateway", Field: "apigateway", Package: "apigateway"},
+	{EndpointName: "apigateway", Field: "apigatewayv2conn", Package: "apigatewayv2"},
+	{EndpointName: "applicationautoscaling", Field: "appautoscalingconn", Package: "applicationautoscaling"},
+	{EndpointName: "appmesh", Field: "appmeshconn", Package: "appmesh"},
+	{EndpointName: "appsync", Field: "appsyncconn", Package: "appsync"},
+	{EndpointName: "athena", Field: "athenaconn", Package: "athena"},
+	{EndpointName: "autoscaling", Field: "autoscalingconn", Package: "autoscaling"},
+	{EndpointName: "backup", Field: "backupconn", Package: "backup"},
+	{EndpointName: "batch", Field: "batchconn", Package: "batch"},
+	{EndpointName: "budgets", Field: "budgetconn", Package: "budgets"},
+	{EndpointName: "cloud9", Field: "cloud9conn", Package: "cloud9"},
+	{EndpointName: "cloudformation", Field: "cfconn", Package: "cloudformation"},
+	{EndpointName: "cloudfront", Field: "cloudfrontconn", Package: "cloudfront"},
+	{EndpointName: "cloudhsm", Field: "cloudhsmv2conn", Package: "cloudhsmv2"},
+	{EndpointName: "cloudsearch", Field: "cloudsearchconn", Package: "cloudsearch"},
+	{EndpointName: "cloudtrail", Field: "cloudtrailconn", Package: "cloudtrail"},
+	{EndpointName: "cloudwatch", Field: "cloudwatchconn", Package: "cloudwatch"},
+	{EndpointName: "cloudwatchevents", Field: "cloudwatcheventsconn", Package: "cloudwatchevents"},
+	{EndpointName: "cloudwatchlogs", Field: "cloudwatchlogsconn", Package: "cloudwatchlogs"},
+	{EndpointName: "codebuild", Field: "codebuildconn", Package: "codebuild"},
+	{EndpointName: "codecommit", Field: "codecommitconn", Package: "codecommit"},
+	{EndpointName: "codedeploy", Field: "codedeployconn", Package: "codedeploy"},
+	{EndpointName: "codepipeline", Field: "codepipelineconn", Package: "codepipeline"},
+	{EndpointName: "cognitoidentity", Field: "cognitoconn", Package: "cognitoidentity"},
+	{EndpointName: "cognitoidp", Field: "cognitoidpconn", Package: "cognitoidentityprovider"},
+	{EndpointName: "configservice", Field: "configconn", Package: "configservice"},
+	{EndpointName: "cur", Field: "costandusagereportconn", Package: "costandusagereportservice"},
+	{EndpointName: "datapipeline", Field: "datapipelineconn", Package: "datapipeline"},
+	{EndpointName: "datasync", Field: "datasyncconn", Package: "datasync"},
+	{EndpointName: "dax", Field: "daxconn", Package: "dax"},
+	{EndpointName: "devicefarm", Field: "devicefarmconn", Package: "devicefarm"},
+	{EndpointName: "directconnect", Field: "dxconn", Package: "directconnect"},
+	{EndpointName: "dlm", Field: "dlmconn", Package: "dlm"},
+	{EndpointName: "dms", Field: "dmsconn", Package: "databasemigrationservice"},
+	{EndpointName: "docdb", Field: "docdbconn", Package: "docdb"},
+	{EndpointName: "ds", Field: "dsconn", Package: "directoryservice"},
+	{EndpointName: "dynamodb", Field: "dynamodbconn", Package: "dynamodb"},
+	{EndpointName: "ec2", Field: "ec2conn", Package: "ec2"},
+	{EndpointName: "ecr", Field: "ecrconn", Package: "ecr"},
+	{EndpointName: "ecs", Field: "ecsconn", Package: "ecs"},
+	{EndpointName: "efs", Field: "efsconn", Package: "efs"},
+	{EndpointName: "eks", Field: "eksconn", Package: "eks"},
+	{EndpointName: "elasticache", Field: "elasticacheconn", Package: "elasticache"},
+	{EndpointName: "elasticbeanstalk", Field: "elasticbeanstalkconn", Package: "elasticbeanstalk"},
+	{EndpointName: "elastictranscoder", Field: "elastictranscoderconn", Package: "elastictranscoder"},
+	{EndpointName: "elb", Field: "elbconn", Package: "elb"},
+	{EndpointName: "elb", Field: "elbv2conn", Package: "elbv2"},
+	{EndpointName: "emr", Field: "emrconn", Package: "emr"},
+	{EndpointName: "es", Field: "esconn", Package: "elasticsearchservice"},
+	{EndpointName: "firehose", Field: "firehoseconn", Package: "firehose"},
+	{EndpointName: "fms", Field: "fmsconn", Package: "fms"},
+	{EndpointName: "fsx", Field: "fsxconn", Package: "fsx"},
+	{EndpointName: "gamelift", Field: "gameliftconn", Package: "gamelift"},
+	{EndpointName: "glacier", Field: "glacierconn", Package: "glacier"},
+	{EndpointName: "globalaccelerator", Field: "globalacceleratorconn", Package: "globalaccelerator", Region: "us-west-2"},
+	{EndpointName: "glue", Field: "glueconn", Package: "glue"},
+	{EndpointName: "guardduty", Field: "guarddutyconn", Package: "guardduty"},
+	{EndpointName: "iam", Field: "iamconn", Package: "iam"},
+	{EndpointName: "inspector", Field: "inspectorconn", Package: "inspector"},
+	{EndpointName: "iot", Field: "iotconn", Package: "iot"},
+	{EndpointName: "kafka", Field: "kafkaconn", Package: "kafka"},
+	{EndpointName: "kinesis", Field: "kinesisconn", Package: "kinesis"},
+	{EndpointName: "kinesisanalytics", Field: "kinesisanalyticsconn", Package: "kinesisanalytics"},
+	{EndpointName: "kinesisanalytics", Field: "kinesisanalyticsv2conn", Package: "kinesisanalyticsv2"},
+	{EndpointName: "kinesisvideo", Field: "kinesisvideoconn", Package: "kinesisvideo"},
+	{EndpointName: "kms", Field: "kmsconn", Package: "kms"},
+	{EndpointName: "lambda", Field: "lambdaconn", Package: "lambda"},
+	{EndpointName: "lexmodels", Field: "lexmodelconn", Package: "lexmodelbuildingservice"},
+	{EndpointName: "licensemanager", Field: "licensemanagerconn", Package: "licensemanager"},
+	{EndpointName: "lightsail", Field: "lightsailconn", Package: "lightsail"},
+	{EndpointName: "macie", Field: "macieconn", Package: "macie"},
+	{EndpointName: "managedblockchain", Field: "managedblockchainconn", Package: "managedblockchain"},
+	{EndpointName: "mediaconnect", Field: "mediaconnectconn", Package: "mediaconnect"},
+	{EndpointName: "mediaconvert", Field: "mediaconvertconn", Package: "mediaconvert"},
+	{EndpointName: "medialive", Field: "medialiveconn", Package: "medialive"},
+	{EndpointName: "mediapackage", Field: "mediapackageconn", Package: "mediapackage"},
+	{EndpointName: "mediastore", Field: "mediastoreconn", Package: "mediastore"},
+	{EndpointName: "mediastoredata", Field: "mediastoredataconn", Package: "mediastoredata"},
+	{EndpointName: "mq", Field: "mqconn", Package: "mq"},
+	{EndpointName: "neptune", Field: "neptuneconn", Package: "neptune"},
+	{EndpointName: "opsworks", Field: "opsworksconn", Package: "opsworks"},
+	{EndpointName: "organizations", Field: "organizationsconn", Package: "organizations"},
+	{EndpointName: "pinpoint", Field: "pinpointconn", Package: "pinpoint"},
+	{EndpointName: "pricing", Field: "pricingconn", Package: "pricing"},
+	{EndpointName: "quicksight", Field: "quicksightconn", Package: "quicksight"},
+	{EndpointName: "ram", Field: "ramconn", Package: "ram"},
+	{EndpointName: "rds", Field: "rdsconn", Package: "rds"},
+	{EndpointName: "redshift", Field: "redshiftconn", Package: "redshift"},
+	{EndpointName: "resourcegroups", Field: "resourcegroupsconn", Package: "resourcegroups"},
+	{EndpointName: "route53", Field: "r53conn", Package: "route53", Region: "us-east-1"},
+	{EndpointName: "route53resolver", Field: "route53resolverconn", Package: "route53resolver"},
+	{EndpointName: "s3", Field: "s3conn", Package: "s3", ExtraConfig: "S3ForcePathStyle: aws.Bool(c.S3ForcePathStyle)"},
+	{EndpointName: "s3control", Field: "s3controlconn", Package: "s3control"},
+	{EndpointName: "sagemaker", Field: "sagemakerconn", Package: "sagemaker"},
+	{EndpointName: "sdb", Field: "simpledbconn", Package: "simpledb"},
+	{EndpointName: "secretsmanager", Field: "secretsmanagerconn", Package: "secretsmanager"},
+	{EndpointName: "securityhub", Field: "securityhubconn", Package: "securityhub"},
+	{EndpointName: "serverlessrepo", Field: "serverlessapplicationrepositoryconn", Package: "serverlessapplicationrepository"},
+	{EndpointName: "servicecatalog", Field: "scconn", Package: "servicecatalog"},
+	{EndpointName: "servicediscovery", Field: "sdconn", Package: "servicediscovery"},
+	{EndpointName: "ses", Field: "sesConn", Package: "ses"},
+	{EndpointName: "shield", Field: "shieldconn", Package: "shield", Region: "us-east-1"},
+	{EndpointName: "sns", Field: "snsconn", Package: "sns"},
+	{EndpointName: "sqs", Field: "sqsconn", Package: "sqs"},
+	{EndpointName: "ssm", Field: "ssmconn", Package: "ssm"},
+	{EndpointName: "stepfunctions", Field: "sfnconn", Package: "sfn"},
+	{EndpointName: "storagegateway", Field: "storagegatewayconn", Package: "storagegateway"},
+	{EndpointName: "sts", Field: "stsconn", Package: "sts"},
+	{EndpointName: "swf", Field: "swfconn", Package: "swf"},
+	{EndpointName: "transfer", Field: "transferconn", Package: "transfer"},
+	{EndpointName: "waf", Field: "wafconn", Package: "waf"},
+	{EndpointName: "wafregional", Field: "wafregionalconn", Package: "wafregional"},
+	{EndpointName: "worklink", Field: "worklinkconn", Package: "worklink"},
+	{EndpointName: "workspaces", Field: "workspacesconn", Package: "workspaces"},
+	{EndpointName: "xray", Field: "xrayconn", Package: "xray"},
+}
+
+var deprecatedEndpoints = []DeprecatedEndpoint{
+	{EndpointName: "kinesis_analytics", Field: "kinesisanalyticsconn"},
+	{EndpointName: "r53", Field: "r53conn"},
+}
+
+type TemplateData struct {
+	DeprecatedEndpoints []DeprecatedEndpointData
+	EndpointNames       []string
+	EndpointClients     []EndpointClientsData
+	Packages            []string
+	ServiceClients      []ServiceClient
+}
+
+type DeprecatedEndpointData struct {
+	DeprecatedEndpointName string
+	ServiceClient
+}
+
+type EndpointClientsData struct {
+	EndpointName string
+	Fields       []string
+}
+
+func main() {
+	templateData := TemplateData{}
+	endpointFields := make(map[string][]string)
+	packages := make(map[string]bool)
+
+	for _, serviceClient := range serviceClients {
+		if _, ok := endpointFields[serviceClient.EndpointName]; !ok {
+			templateData.EndpointNames = append(templateData.EndpointNames, serviceClient.EndpointName)
+		}
+
+		endpointFields[serviceClient.EndpointName] = append(endpointFields[serviceClient.EndpointName], serviceClient.Field)
+		packages[serviceClient.Package] = true
+	}
+
+	for _, deprecatedEndpoint := range deprecatedEndpoints {
+		var found bool
+
+		for _, serviceClient := range serviceClients {
+			if serviceClient.Field != deprecatedEndpoint.Field {
+				continue
+			}
+
+			found = true
+			templateData.DeprecatedEndpoints = append(templateData.DeprecatedEndpoints, DeprecatedEndpointData{
+				DeprecatedEndpointName: deprecatedEndpoint.EndpointName,
+				ServiceClient:          serviceClient,
+			})
+		}
+
+		if !found {
+			log.Fatalf("deprecated endpoint %s: unknown service client field %s", deprecatedEndpoint.EndpointName, deprecatedEndpoint.Field)
+		}
+
+		templateData.EndpointNames = append(templateData.EndpointNames, deprecatedEndpoint.EndpointName)
+	}
+
+	sort.Strings(templateData.EndpointNames)
+
+	for _, endpointName := range templateData.EndpointNames {
+		// Deprecated endpoint names share the service clients of the
+		// endpoint they override, so they are not listed separately.
+		if _, ok := endpointFields[endpointName]; !ok {
+			continue
+		}
+
+		templateData.EndpointClients = append(templateData.EndpointClients, EndpointClientsData{
+			EndpointName: endpointName,
+			Fields:       endpointFields[endpointName],
+		})
+	}
+
+	for p := range packages {
+		templateData.Packages = append(templateData.Packages, p)
+	}
+
+	sort.Strings(templateData.Packages)
+
+	templateData.ServiceClients = make([]ServiceClient, len(serviceClients))
+	copy(templateData.ServiceClients, serviceClients)
+
+	sort.SliceStable(templateData.ServiceClients, func(i, j int) bool {
+		return templateData.ServiceClients[i].Field < templateData.ServiceClients[j].Field
+	})
+
+	tmpl, err := template.New("serviceclients").Parse(templateBody)
+
+	if err != nil {
+		log.Fatalf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	err = tmpl.Execute(&buffer, templateData)
+
+	if err != nil {
+		log.Fatalf("error executing template: %s", err)
+	}
+
+	generatedFileContents, err := format.Source(buffer.Bytes())
+
+	if err != nil {
+		log.Fatalf("error formatting generated file: %s", err)
+	}
+
+	f, err := os.Create(filename)
+
+	if err != nil {
+		log.Fatalf("error creating file (%s): %s", filename, err)
+	}
+
+	defer f.Close()
+
+	_, err = f.Write(generatedFileContents)
+
+	if err != nil {
+		log.Fatalf("error writing to file (%s): %s", filename, err)
+	}
+}
+
+var templateBody = `
+// Code generated by internal/generators/serviceclients/main.go; DO NOT EDIT.
+
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/session"
+{{- range .Packages }}
+	"github.com/aws/aws-sdk-go/service/{{ . }}"
+{{- end }}
+)
+
+// endpointServiceNames are the provider endpoints configuration block arguments.
+var endpointServiceNames = []string{
+{{- range .EndpointNames }}
+	"{{ . }}",
+{{- end }}
+}
+
+// deprecatedEndpointServiceNames maps the deprecated endpoints configuration
+// block arguments to the argument of the service clients they override.
+var deprecatedEndpointServiceNames = map[string]string{
+{{- range .DeprecatedEndpoints }}
+	"{{ .DeprecatedEndpointName }}": "{{ .EndpointName }}",
+{{- end }}
+}
+
+// configureServiceClients creates the AWSClient service clients from the
+// session, applying any custom endpoints.
+func (c *Config) configureServiceClients(sess *session.Session, client *AWSClient) {
+{{- range .ServiceClients }}
+	client.{{ .Field }} = {{ .Package }}.New(sess.Copy(&aws.Config{ {{- if .Region }}Region: aws.String("{{ .Region }}"), {{ end }}Endpoint: aws.String(c.Endpoints["{{ .EndpointName }}"]){{ if .ExtraConfig }}, {{ .ExtraConfig }}{{ end }}}))
+{{- end }}
+
+	// Handle deprecated endpoint configurations
+{{- range .DeprecatedEndpoints }}
+	if c.Endpoints["{{ .DeprecatedEndpointName }}"] != "" {
+		client.{{ .Field }} = {{ .Package }}.New(sess.Copy(&aws.Config{ {{- if .Region }}Region: aws.String("{{ .Region }}"), {{ end }}Endpoint: aws.String(c.Endpoints["{{ .DeprecatedEndpointName }}"]){{ if .ExtraConfig }}, {{ .ExtraConfig }}{{ end }}}))
+	}
+{{- end }}
+}
+
+// endpointServiceClients returns the service clients keyed by their
+// endpoints configuration block argument. Deprecated arguments are not
+// included, see deprecatedEndpointServiceNames.
+func (c *AWSClient) endpointServiceClients() map[string][]*client.Client {
+	return map[string][]*client.Client{
+{{- range .EndpointClients }}
+		"{{ .EndpointName }}": { {{- range $i, $field := .Fields }}{{ if $i }}, {{ end }}c.{{ $field }}.Client{{ end }}},
+{{- end }}
+	}
+}
+`
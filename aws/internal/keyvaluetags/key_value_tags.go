@@ -0,0 +1,208 @@
+package keyvaluetags
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+)
+
+const (
+	AwsTagKeyPrefix = `aws:`
+)
+
+// KeyValueTags is a standard implementation for AWS key-value resource tags.
+// The AWS Go SDK is split into multiple service packages, each service with
+// its own Go struct type representing a resource tag. To standardize logic
+// across all these Go types, we convert them into this Go type.
+type KeyValueTags map[string]*string
+
+// IgnoreAws returns non-AWS tag keys.
+func (tags KeyValueTags) IgnoreAws() KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if !strings.HasPrefix(k, AwsTagKeyPrefix) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// Keys returns tag keys in sorted order.
+func (tags KeyValueTags) Keys() []string {
+	result := make([]string, 0, len(tags))
+
+	for k := range tags {
+		result = append(result, k)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// Map returns tag keys mapped to their values.
+func (tags KeyValueTags) Map() map[string]string {
+	result := make(map[string]string, len(tags))
+
+	for k, v := range tags {
+		if v == nil {
+			result[k] = ""
+			continue
+		}
+
+		result[k] = *v
+	}
+
+	return result
+}
+
+// Merged returns the tags with the given tags added, overwriting the values
+// of existing keys.
+func (tags KeyValueTags) Merged(mergeTags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags, len(tags)+len(mergeTags))
+
+	for k, v := range tags {
+		result[k] = v
+	}
+
+	for k, v := range mergeTags {
+		result[k] = v
+	}
+
+	return result
+}
+
+// Removed returns tags whose keys are missing from the new tags.
+func (tags KeyValueTags) Removed(newTags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if _, ok := newTags[k]; !ok {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// Updated returns new tags that are added or whose values changed.
+func (tags KeyValueTags) Updated(newTags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, newV := range newTags {
+		if oldV, ok := tags[k]; !ok || !stringPointerValueEqual(oldV, newV) {
+			result[k] = newV
+		}
+	}
+
+	return result
+}
+
+// Chunks returns the tags split into groups of at most size tags, in key
+// order, for APIs that limit the number of tags per call.
+func (tags KeyValueTags) Chunks(size int) []KeyValueTags {
+	if size < 1 {
+		size = 1
+	}
+
+	result := make([]KeyValueTags, 0, (len(tags)+size-1)/size)
+
+	for i, k := range tags.Keys() {
+		if i%size == 0 {
+			result = append(result, make(KeyValueTags, size))
+		}
+
+		result[len(result)-1][k] = tags[k]
+	}
+
+	return result
+}
+
+// UrlEncode returns the tags as URL query parameters, e.g. for the S3
+// x-amz-tagging header.
+func (tags KeyValueTags) UrlEncode() string {
+	values := url.Values{}
+
+	for k, v := range tags.Map() {
+		values.Add(k, v)
+	}
+
+	return values.Encode()
+}
+
+// NewFromUrlQuery creates KeyValueTags from URL query parameters.
+func NewFromUrlQuery(query string) (KeyValueTags, error) {
+	values, err := url.ParseQuery(query)
+
+	if err != nil {
+		return nil, err
+	}
+
+	kvtm := make(KeyValueTags, len(values))
+
+	for k, v := range values {
+		str := ""
+
+		if len(v) > 0 {
+			str = v[0]
+		}
+
+		kvtm[k] = &str
+	}
+
+	return kvtm, nil
+}
+
+// New creates KeyValueTags from common Terraform Provider SDK types.
+// Supports map[string]string, map[string]*string, map[string]interface{},
+// and []interface{} (keys only).
+func New(i interface{}) KeyValueTags {
+	switch value := i.(type) {
+	case map[string]string:
+		kvtm := make(KeyValueTags, len(value))
+
+		for k, v := range value {
+			str := v // Prevent referencing issues
+			kvtm[k] = &str
+		}
+
+		return kvtm
+	case map[string]*string:
+		kvtm := make(KeyValueTags, len(value))
+
+		for k, v := range value {
+			kvtm[k] = v
+		}
+
+		return kvtm
+	case map[string]interface{}:
+		kvtm := make(KeyValueTags, len(value))
+
+		for k, v := range value {
+			str := v.(string)
+			kvtm[k] = &str
+		}
+
+		return kvtm
+	case []interface{}:
+		kvtm := make(KeyValueTags, len(value))
+
+		for _, v := range value {
+			kvtm[v.(string)] = nil
+		}
+
+		return kvtm
+	default:
+		return make(KeyValueTags)
+	}
+}
+
+func stringPointerValueEqual(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
@@ -0,0 +1,381 @@
+package keyvaluetags
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestKeyValueTagsIgnoreAws(t *testing.T) {
+	testCases := []struct {
+		name string
+		tags KeyValueTags
+		want map[string]string
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+			want: map[string]string{},
+		},
+		{
+			name: "all",
+			tags: New(map[string]string{
+				"aws:cloudformation:key1": "value1",
+				"aws:cloudformation:key2": "value2",
+			}),
+			want: map[string]string{},
+		},
+		{
+			name: "mixed",
+			tags: New(map[string]string{
+				"aws:cloudformation:key1": "value1",
+				"key2":                    "value2",
+			}),
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.IgnoreAws()
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsKeys(t *testing.T) {
+	tags := New(map[string]string{
+		"key3": "value3",
+		"key1": "value1",
+		"key2": "value2",
+	})
+
+	want := []string{"key1", "key2", "key3"}
+
+	if got := tags.Keys(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, expected %v", got, want)
+	}
+}
+
+func TestKeyValueTagsMerged(t *testing.T) {
+	testCases := []struct {
+		name      string
+		tags      KeyValueTags
+		mergeTags KeyValueTags
+		want      map[string]string
+	}{
+		{
+			name:      "empty",
+			tags:      New(map[string]string{}),
+			mergeTags: New(map[string]string{}),
+			want:      map[string]string{},
+		},
+		{
+			name: "add",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			mergeTags: New(map[string]string{
+				"key2": "value2",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name: "overwrite",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			mergeTags: New(map[string]string{
+				"key2": "value2updated",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2updated",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.Merged(testCase.mergeTags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsRemoved(t *testing.T) {
+	testCases := []struct {
+		name    string
+		oldTags KeyValueTags
+		newTags KeyValueTags
+		want    map[string]string
+	}{
+		{
+			name:    "empty",
+			oldTags: New(map[string]string{}),
+			newTags: New(map[string]string{}),
+			want:    map[string]string{},
+		},
+		{
+			name: "all new",
+			oldTags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			newTags: New(map[string]string{
+				"key3": "value3",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name: "value change only",
+			oldTags: New(map[string]string{
+				"key1": "value1",
+			}),
+			newTags: New(map[string]string{
+				"key1": "value1updated",
+			}),
+			want: map[string]string{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.oldTags.Removed(testCase.newTags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsUpdated(t *testing.T) {
+	testCases := []struct {
+		name    string
+		oldTags KeyValueTags
+		newTags KeyValueTags
+		want    map[string]string
+	}{
+		{
+			name:    "empty",
+			oldTags: New(map[string]string{}),
+			newTags: New(map[string]string{}),
+			want:    map[string]string{},
+		},
+		{
+			name: "added",
+			oldTags: New(map[string]string{
+				"key1": "value1",
+			}),
+			newTags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+		{
+			name: "changed",
+			oldTags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			newTags: New(map[string]string{
+				"key1": "value1updated",
+				"key2": "value2",
+			}),
+			want: map[string]string{
+				"key1": "value1updated",
+			},
+		},
+		{
+			name: "removed only",
+			oldTags: New(map[string]string{
+				"key1": "value1",
+			}),
+			newTags: New(map[string]string{}),
+			want:    map[string]string{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.oldTags.Updated(testCase.newTags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsChunks(t *testing.T) {
+	testCases := []struct {
+		name string
+		tags KeyValueTags
+		size int
+		want []int
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+			size: 10,
+			want: []int{},
+		},
+		{
+			name: "under size",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			size: 10,
+			want: []int{2},
+		},
+		{
+			name: "exact size",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			size: 2,
+			want: []int{2},
+		},
+		{
+			name: "over size",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+				"key4": "value4",
+				"key5": "value5",
+			}),
+			size: 2,
+			want: []int{2, 2, 1},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.Chunks(testCase.size)
+			gotSizes := make([]int, 0, len(got))
+			merged := New(map[string]string{})
+
+			for _, chunk := range got {
+				gotSizes = append(gotSizes, len(chunk))
+				merged = merged.Merged(chunk)
+			}
+
+			if !reflect.DeepEqual(gotSizes, testCase.want) {
+				t.Errorf("got chunk sizes %v, expected %v", gotSizes, testCase.want)
+			}
+
+			testKeyValueTagsVerifyMap(t, merged.Map(), testCase.tags.Map())
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	testCases := []struct {
+		name   string
+		source interface{}
+		want   map[string]string
+	}{
+		{
+			name:   "map_string_interface",
+			source: map[string]interface{}{"key1": "value1"},
+			want:   map[string]string{"key1": "value1"},
+		},
+		{
+			name:   "map_string_string",
+			source: map[string]string{"key1": "value1"},
+			want:   map[string]string{"key1": "value1"},
+		},
+		{
+			name:   "list_interface",
+			source: []interface{}{"key1"},
+			want:   map[string]string{"key1": ""},
+		},
+		{
+			name:   "unsupported",
+			source: 42,
+			want:   map[string]string{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := New(testCase.source)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func testKeyValueTagsVerifyMap(t *testing.T, got map[string]string, want map[string]string) {
+	t.Helper()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, expected %v", got, want)
+	}
+}
+
+func TestKeyValueTagsUrlEncode(t *testing.T) {
+	testCases := []struct {
+		name string
+		tags KeyValueTags
+		want string
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+			want: "",
+		},
+		{
+			name: "single",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			want: "key1=value1",
+		},
+		{
+			name: "multiple sorted and escaped",
+			tags: New(map[string]string{
+				"key2": "value 2",
+				"key1": "value=1&",
+			}),
+			want: "key1=value%3D1%26&key2=value+2",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.UrlEncode()
+
+			if got != testCase.want {
+				t.Errorf("got %q, expected %q", got, testCase.want)
+			}
+
+			roundTrip, err := NewFromUrlQuery(got)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			testKeyValueTagsVerifyMap(t, roundTrip.Map(), testCase.tags.Map())
+		})
+	}
+}
+
+func TestNewFromUrlQueryError(t *testing.T) {
+	if _, err := NewFromUrlQuery("key1=%zz"); err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,61 @@
+package keyvaluetags
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+)
+
+// CloudformationTags returns cloudformation service tags.
+func (tags KeyValueTags) CloudformationTags() []*cloudformation.Tag {
+	result := make([]*cloudformation.Tag, 0, len(tags))
+
+	for _, k := range tags.Keys() {
+		tag := &cloudformation.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// CloudformationKeyValueTags creates KeyValueTags from cloudformation service tags.
+func CloudformationKeyValueTags(tags []*cloudformation.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// LightsailTags returns lightsail service tags.
+func (tags KeyValueTags) LightsailTags() []*lightsail.Tag {
+	result := make([]*lightsail.Tag, 0, len(tags))
+
+	for _, k := range tags.Keys() {
+		tag := &lightsail.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// LightsailKeyValueTags creates KeyValueTags from lightsail service tags.
+func LightsailKeyValueTags(tags []*lightsail.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
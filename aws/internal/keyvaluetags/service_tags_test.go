@@ -0,0 +1,87 @@
+package keyvaluetags
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+)
+
+func TestKeyValueTagsCloudformationTags(t *testing.T) {
+	tags := New(map[string]string{
+		"key2": "value2",
+		"key1": "value1",
+	})
+
+	got := tags.CloudformationTags()
+
+	if len(got) != 2 {
+		t.Fatalf("got %d tags, expected 2", len(got))
+	}
+
+	if aws.StringValue(got[0].Key) != "key1" || aws.StringValue(got[0].Value) != "value1" {
+		t.Errorf("got first tag %s, expected key1=value1", got[0])
+	}
+
+	if aws.StringValue(got[1].Key) != "key2" || aws.StringValue(got[1].Value) != "value2" {
+		t.Errorf("got second tag %s, expected key2=value2", got[1])
+	}
+}
+
+func TestCloudformationKeyValueTags(t *testing.T) {
+	tags := []*cloudformation.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	testKeyValueTagsVerifyMap(t, CloudformationKeyValueTags(tags).Map(), map[string]string{
+		"key1": "value1",
+		"key2": "value2",
+	})
+}
+
+func TestKeyValueTagsLightsailTags(t *testing.T) {
+	tags := New(map[string]string{
+		"key2": "value2",
+		"key1": "value1",
+	})
+
+	got := tags.LightsailTags()
+
+	if len(got) != 2 {
+		t.Fatalf("got %d tags, expected 2", len(got))
+	}
+
+	if aws.StringValue(got[0].Key) != "key1" || aws.StringValue(got[0].Value) != "value1" {
+		t.Errorf("got first tag %s, expected key1=value1", got[0])
+	}
+
+	if aws.StringValue(got[1].Key) != "key2" || aws.StringValue(got[1].Value) != "value2" {
+		t.Errorf("got second tag %s, expected key2=value2", got[1])
+	}
+}
+
+func TestLightsailKeyValueTags(t *testing.T) {
+	tags := []*lightsail.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	testKeyValueTagsVerifyMap(t, LightsailKeyValueTags(tags).Map(), map[string]string{
+		"key1": "value1",
+		"key2": "value2",
+	})
+}
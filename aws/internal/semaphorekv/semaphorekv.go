@@ -0,0 +1,72 @@
+package semaphorekv
+
+import (
+	"fmt"
+	"log"
+	"sync"
+)
+
+// SemaphoreKV is a simple key/value store for counting semaphores. It can be used
+// to bound the number of concurrent changes across arbitrary collaborators that
+// share knowledge of the keys they must coordinate on, while changes to different
+// keys proceed independently.
+//
+// The initial use case is to let aws_security_group_rule resources authorize and
+// revoke a bounded number of rules at a time on each security group, rather than
+// fully serializing access to the security group.
+type SemaphoreKV struct {
+	lock  sync.Mutex
+	size  int
+	store map[string]chan struct{}
+}
+
+// Acquire blocks until a slot is available for the given key. Caller is
+// responsible for calling Release for the same key.
+func (s *SemaphoreKV) Acquire(key string) {
+	log.Printf("[DEBUG] Acquiring semaphore %q", key)
+	s.get(key) <- struct{}{}
+	log.Printf("[DEBUG] Acquired semaphore %q", key)
+}
+
+// Release frees a slot for the given key. Caller must have called Acquire for
+// the same key first.
+func (s *SemaphoreKV) Release(key string) {
+	log.Printf("[DEBUG] Releasing semaphore %q", key)
+	select {
+	case <-s.get(key):
+	default:
+		panic(fmt.Sprintf("release of unacquired semaphore %q", key))
+	}
+	log.Printf("[DEBUG] Released semaphore %q", key)
+}
+
+// Size returns the maximum number of concurrent holders for each key.
+func (s *SemaphoreKV) Size() int {
+	return s.size
+}
+
+// get returns the semaphore for the given key, no guarantee of its status.
+func (s *SemaphoreKV) get(key string) chan struct{} {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	sem, ok := s.store[key]
+	if !ok {
+		sem = make(chan struct{}, s.size)
+		s.store[key] = sem
+	}
+	return sem
+}
+
+// NewSemaphoreKV returns a properly initialized SemaphoreKV allowing up to size
+// concurrent holders per key. A size less than 1 is treated as 1, which
+// behaves like a keyed mutex.
+func NewSemaphoreKV(size int) *SemaphoreKV {
+	if size < 1 {
+		size = 1
+	}
+
+	return &SemaphoreKV{
+		size:  size,
+		store: make(map[string]chan struct{}),
+	}
+}
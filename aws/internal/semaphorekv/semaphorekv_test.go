@@ -0,0 +1,109 @@
+package semaphorekv
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNewSemaphoreKV(t *testing.T) {
+	testCases := []struct {
+		name string
+		size int
+		want int
+	}{
+		{
+			name: "negative",
+			size: -1,
+			want: 1,
+		},
+		{
+			name: "zero",
+			size: 0,
+			want: 1,
+		},
+		{
+			name: "positive",
+			size: 5,
+			want: 5,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := NewSemaphoreKV(testCase.size).Size()
+
+			if got != testCase.want {
+				t.Errorf("got %d, expected %d", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestSemaphoreKVBoundsConcurrencyPerKey(t *testing.T) {
+	const size = 3
+	s := NewSemaphoreKV(size)
+
+	var current, max int32
+	var wg sync.WaitGroup
+
+	for i := 0; i < 4*size; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			s.Acquire("key")
+			defer s.Release("key")
+
+			n := atomic.AddInt32(&current, 1)
+			for {
+				m := atomic.LoadInt32(&max)
+				if n <= m || atomic.CompareAndSwapInt32(&max, m, n) {
+					break
+				}
+			}
+
+			time.Sleep(10 * time.Millisecond)
+			atomic.AddInt32(&current, -1)
+		}()
+	}
+
+	wg.Wait()
+
+	if max > size {
+		t.Errorf("got %d concurrent holders, expected at most %d", max, size)
+	}
+}
+
+func TestSemaphoreKVIndependentKeys(t *testing.T) {
+	s := NewSemaphoreKV(1)
+
+	s.Acquire("key1")
+	defer s.Release("key1")
+
+	done := make(chan struct{})
+	go func() {
+		s.Acquire("key2")
+		s.Release("key2")
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("acquiring key2 blocked on key1")
+	}
+}
+
+func TestSemaphoreKVReleaseUnacquired(t *testing.T) {
+	s := NewSemaphoreKV(1)
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected panic releasing unacquired semaphore")
+		}
+	}()
+
+	s.Release("key")
+}
@@ -0,0 +1,98 @@
+package aws
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// MediaConvert job template and preset settings mirror the API's settings model, which has
+// hundreds of nested fields. Like ECS container definitions, they are configured as JSON
+// in the API's own shape and decoded into the SDK types, so every field is supported.
+
+// expandMediaConvertSettings decodes settings JSON into the given SDK settings struct.
+func expandMediaConvertSettings(settings string, v interface{}) error {
+	return jsonutil.UnmarshalJSON(v, strings.NewReader(settings))
+}
+
+// flattenMediaConvertSettings encodes an SDK settings struct as canonical JSON.
+func flattenMediaConvertSettings(v interface{}) (string, error) {
+	b, err := jsonutil.BuildJSON(v)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// validateMediaConvertSettings returns a ValidateFunc that checks settings JSON decodes into
+// the SDK settings struct returned by newSettings without dropping any unknown fields.
+func validateMediaConvertSettings(newSettings func() interface{}) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(string)
+
+		var raw interface{}
+		if err := json.Unmarshal([]byte(value), &raw); err != nil {
+			errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %s", k, err))
+			return
+		}
+
+		settings := newSettings()
+		if err := expandMediaConvertSettings(value, settings); err != nil {
+			errors = append(errors, fmt.Errorf("%q contains invalid settings: %s", k, err))
+			return
+		}
+
+		canonical, err := flattenMediaConvertSettings(settings)
+		if err != nil {
+			errors = append(errors, fmt.Errorf("%q contains invalid settings: %s", k, err))
+			return
+		}
+
+		var decoded interface{}
+		if err := json.Unmarshal([]byte(canonical), &decoded); err != nil {
+			errors = append(errors, fmt.Errorf("%q contains invalid settings: %s", k, err))
+			return
+		}
+
+		if !reflect.DeepEqual(raw, decoded) {
+			errors = append(errors, fmt.Errorf("%q contains unknown fields or null values, expected settings equivalent to: %s", k, canonical))
+		}
+
+		return
+	}
+}
+
+// suppressEquivalentMediaConvertSettings returns a DiffSuppressFunc that compares settings
+// JSON after decoding both values into the SDK settings struct returned by newSettings.
+func suppressEquivalentMediaConvertSettings(newSettings func() interface{}) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		oldSettings := newSettings()
+		if err := expandMediaConvertSettings(old, oldSettings); err != nil {
+			return false
+		}
+
+		newSettingsValue := newSettings()
+		if err := expandMediaConvertSettings(new, newSettingsValue); err != nil {
+			return false
+		}
+
+		oldJSON, err := jsonutil.BuildJSON(oldSettings)
+		if err != nil {
+			return false
+		}
+
+		newJSON, err := jsonutil.BuildJSON(newSettingsValue)
+		if err != nil {
+			return false
+		}
+
+		return bytes.Equal(oldJSON, newJSON)
+	}
+}
@@ -1,10 +1,14 @@
 package aws
 
 import (
+	"fmt"
 	"log"
+	"os"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/mutexkv"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
 	homedir "github.com/mitchellh/go-homedir"
 )
@@ -71,6 +75,32 @@ func Provider() terraform.ResourceProvider {
 				Default:     25,
 				Description: descriptions["max_retries"],
 			},
+			"eventual_consistency_timeout": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "2m",
+				Description: descriptions["eventual_consistency_timeout"],
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					duration, err := time.ParseDuration(v.(string))
+					if err != nil {
+						errors = append(errors, fmt.Errorf("%q cannot be parsed as a duration: %s", k, err))
+					} else if duration <= 0 {
+						errors = append(errors, fmt.Errorf("%q must be greater than zero", k))
+					}
+					return
+				},
+			},
+			"retry_mode": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     retryModeStandard,
+				Description: descriptions["retry_mode"],
+				ValidateFunc: validation.StringInSlice([]string{
+					retryModeAdaptive,
+					retryModeStandard,
+				}, false),
+			},
+			"service_limits": serviceLimitsSchema(),
 
 			"allowed_account_ids": {
 				Type:          schema.TypeSet,
@@ -141,132 +171,142 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"aws_acm_certificate":                    dataSourceAwsAcmCertificate(),
-			"aws_acmpca_certificate_authority":       dataSourceAwsAcmpcaCertificateAuthority(),
-			"aws_ami":                                dataSourceAwsAmi(),
-			"aws_ami_ids":                            dataSourceAwsAmiIds(),
-			"aws_api_gateway_api_key":                dataSourceAwsApiGatewayApiKey(),
-			"aws_api_gateway_resource":               dataSourceAwsApiGatewayResource(),
-			"aws_api_gateway_rest_api":               dataSourceAwsApiGatewayRestApi(),
-			"aws_api_gateway_vpc_link":               dataSourceAwsApiGatewayVpcLink(),
-			"aws_arn":                                dataSourceAwsArn(),
-			"aws_autoscaling_group":                  dataSourceAwsAutoscalingGroup(),
-			"aws_autoscaling_groups":                 dataSourceAwsAutoscalingGroups(),
-			"aws_availability_zone":                  dataSourceAwsAvailabilityZone(),
-			"aws_availability_zones":                 dataSourceAwsAvailabilityZones(),
-			"aws_batch_compute_environment":          dataSourceAwsBatchComputeEnvironment(),
-			"aws_batch_job_queue":                    dataSourceAwsBatchJobQueue(),
-			"aws_billing_service_account":            dataSourceAwsBillingServiceAccount(),
-			"aws_caller_identity":                    dataSourceAwsCallerIdentity(),
-			"aws_canonical_user_id":                  dataSourceAwsCanonicalUserId(),
-			"aws_cloudformation_export":              dataSourceAwsCloudFormationExport(),
-			"aws_cloudformation_stack":               dataSourceAwsCloudFormationStack(),
-			"aws_cloudhsm_v2_cluster":                dataSourceCloudHsm2Cluster(),
-			"aws_cloudtrail_service_account":         dataSourceAwsCloudTrailServiceAccount(),
-			"aws_cloudwatch_log_group":               dataSourceAwsCloudwatchLogGroup(),
-			"aws_codecommit_repository":              dataSourceAwsCodeCommitRepository(),
-			"aws_cognito_user_pools":                 dataSourceAwsCognitoUserPools(),
-			"aws_cur_report_definition":              dataSourceAwsCurReportDefinition(),
-			"aws_db_cluster_snapshot":                dataSourceAwsDbClusterSnapshot(),
-			"aws_db_event_categories":                dataSourceAwsDbEventCategories(),
-			"aws_db_instance":                        dataSourceAwsDbInstance(),
-			"aws_db_snapshot":                        dataSourceAwsDbSnapshot(),
-			"aws_dx_gateway":                         dataSourceAwsDxGateway(),
-			"aws_dynamodb_table":                     dataSourceAwsDynamoDbTable(),
-			"aws_ebs_snapshot":                       dataSourceAwsEbsSnapshot(),
-			"aws_ebs_snapshot_ids":                   dataSourceAwsEbsSnapshotIds(),
-			"aws_ebs_volume":                         dataSourceAwsEbsVolume(),
-			"aws_ec2_transit_gateway":                dataSourceAwsEc2TransitGateway(),
-			"aws_ec2_transit_gateway_route_table":    dataSourceAwsEc2TransitGatewayRouteTable(),
-			"aws_ec2_transit_gateway_vpc_attachment": dataSourceAwsEc2TransitGatewayVpcAttachment(),
-			"aws_ec2_transit_gateway_vpn_attachment": dataSourceAwsEc2TransitGatewayVpnAttachment(),
-			"aws_ecr_credentials":                    dataSourceAwsEcrCredentials(),
-			"aws_ecr_image":                          dataSourceAwsEcrImage(),
-			"aws_ecr_repository":                     dataSourceAwsEcrRepository(),
-			"aws_ecs_cluster":                        dataSourceAwsEcsCluster(),
-			"aws_ecs_container_definition":           dataSourceAwsEcsContainerDefinition(),
-			"aws_ecs_service":                        dataSourceAwsEcsService(),
-			"aws_ecs_task_definition":                dataSourceAwsEcsTaskDefinition(),
-			"aws_efs_file_system":                    dataSourceAwsEfsFileSystem(),
-			"aws_efs_mount_target":                   dataSourceAwsEfsMountTarget(),
-			"aws_eip":                                dataSourceAwsEip(),
-			"aws_eks_cluster":                        dataSourceAwsEksCluster(),
-			"aws_eks_cluster_auth":                   dataSourceAwsEksClusterAuth(),
-			"aws_elastic_beanstalk_application":      dataSourceAwsElasticBeanstalkApplication(),
-			"aws_elastic_beanstalk_hosted_zone":      dataSourceAwsElasticBeanstalkHostedZone(),
-			"aws_elastic_beanstalk_solution_stack":   dataSourceAwsElasticBeanstalkSolutionStack(),
-			"aws_elasticache_cluster":                dataSourceAwsElastiCacheCluster(),
-			"aws_elasticache_replication_group":      dataSourceAwsElasticacheReplicationGroup(),
-			"aws_elb":                                dataSourceAwsElb(),
-			"aws_elb_hosted_zone_id":                 dataSourceAwsElbHostedZoneId(),
-			"aws_elb_service_account":                dataSourceAwsElbServiceAccount(),
-			"aws_glue_script":                        dataSourceAwsGlueScript(),
-			"aws_iam_account_alias":                  dataSourceAwsIamAccountAlias(),
-			"aws_iam_group":                          dataSourceAwsIAMGroup(),
-			"aws_iam_instance_profile":               dataSourceAwsIAMInstanceProfile(),
-			"aws_iam_policy":                         dataSourceAwsIAMPolicy(),
-			"aws_iam_policy_document":                dataSourceAwsIamPolicyDocument(),
-			"aws_iam_role":                           dataSourceAwsIAMRole(),
-			"aws_iam_server_certificate":             dataSourceAwsIAMServerCertificate(),
-			"aws_iam_user":                           dataSourceAwsIAMUser(),
-			"aws_inspector_rules_packages":           dataSourceAwsInspectorRulesPackages(),
-			"aws_instance":                           dataSourceAwsInstance(),
-			"aws_instances":                          dataSourceAwsInstances(),
-			"aws_internet_gateway":                   dataSourceAwsInternetGateway(),
-			"aws_iot_endpoint":                       dataSourceAwsIotEndpoint(),
-			"aws_ip_ranges":                          dataSourceAwsIPRanges(),
-			"aws_kinesis_stream":                     dataSourceAwsKinesisStream(),
-			"aws_kms_alias":                          dataSourceAwsKmsAlias(),
-			"aws_kms_ciphertext":                     dataSourceAwsKmsCiphertext(),
-			"aws_kms_key":                            dataSourceAwsKmsKey(),
-			"aws_kms_secret":                         dataSourceAwsKmsSecret(),
-			"aws_kms_secrets":                        dataSourceAwsKmsSecrets(),
-			"aws_lambda_function":                    dataSourceAwsLambdaFunction(),
-			"aws_lambda_invocation":                  dataSourceAwsLambdaInvocation(),
-			"aws_lambda_layer_version":               dataSourceAwsLambdaLayerVersion(),
-			"aws_launch_configuration":               dataSourceAwsLaunchConfiguration(),
-			"aws_launch_template":                    dataSourceAwsLaunchTemplate(),
-			"aws_mq_broker":                          dataSourceAwsMqBroker(),
-			"aws_msk_cluster":                        dataSourceAwsMskCluster(),
-			"aws_nat_gateway":                        dataSourceAwsNatGateway(),
-			"aws_network_acls":                       dataSourceAwsNetworkAcls(),
-			"aws_network_interface":                  dataSourceAwsNetworkInterface(),
-			"aws_network_interfaces":                 dataSourceAwsNetworkInterfaces(),
-			"aws_partition":                          dataSourceAwsPartition(),
-			"aws_prefix_list":                        dataSourceAwsPrefixList(),
-			"aws_pricing_product":                    dataSourceAwsPricingProduct(),
-			"aws_ram_resource_share":                 dataSourceAwsRamResourceShare(),
-			"aws_rds_cluster":                        dataSourceAwsRdsCluster(),
-			"aws_redshift_cluster":                   dataSourceAwsRedshiftCluster(),
-			"aws_redshift_service_account":           dataSourceAwsRedshiftServiceAccount(),
-			"aws_region":                             dataSourceAwsRegion(),
-			"aws_route":                              dataSourceAwsRoute(),
-			"aws_route53_delegation_set":             dataSourceAwsDelegationSet(),
-			"aws_route53_zone":                       dataSourceAwsRoute53Zone(),
-			"aws_route_table":                        dataSourceAwsRouteTable(),
-			"aws_route_tables":                       dataSourceAwsRouteTables(),
-			"aws_s3_bucket":                          dataSourceAwsS3Bucket(),
-			"aws_s3_bucket_object":                   dataSourceAwsS3BucketObject(),
-			"aws_secretsmanager_secret":              dataSourceAwsSecretsManagerSecret(),
-			"aws_secretsmanager_secret_version":      dataSourceAwsSecretsManagerSecretVersion(),
-			"aws_security_group":                     dataSourceAwsSecurityGroup(),
-			"aws_security_groups":                    dataSourceAwsSecurityGroups(),
-			"aws_sns_topic":                          dataSourceAwsSnsTopic(),
-			"aws_sqs_queue":                          dataSourceAwsSqsQueue(),
-			"aws_ssm_document":                       dataSourceAwsSsmDocument(),
-			"aws_ssm_parameter":                      dataSourceAwsSsmParameter(),
-			"aws_storagegateway_local_disk":          dataSourceAwsStorageGatewayLocalDisk(),
-			"aws_subnet":                             dataSourceAwsSubnet(),
-			"aws_subnet_ids":                         dataSourceAwsSubnetIDs(),
-			"aws_transfer_server":                    dataSourceAwsTransferServer(),
-			"aws_vpc":                                dataSourceAwsVpc(),
-			"aws_vpc_dhcp_options":                   dataSourceAwsVpcDhcpOptions(),
-			"aws_vpc_endpoint":                       dataSourceAwsVpcEndpoint(),
-			"aws_vpc_endpoint_service":               dataSourceAwsVpcEndpointService(),
-			"aws_vpc_peering_connection":             dataSourceAwsVpcPeeringConnection(),
-			"aws_vpcs":                               dataSourceAwsVpcs(),
-			"aws_vpn_gateway":                        dataSourceAwsVpnGateway(),
-			"aws_workspaces_bundle":                  dataSourceAwsWorkspaceBundle(),
+			"aws_acm_certificate":                          dataSourceAwsAcmCertificate(),
+			"aws_acmpca_certificate_authority":             dataSourceAwsAcmpcaCertificateAuthority(),
+			"aws_ami":                                      dataSourceAwsAmi(),
+			"aws_ami_ids":                                  dataSourceAwsAmiIds(),
+			"aws_api_gateway_api_key":                      dataSourceAwsApiGatewayApiKey(),
+			"aws_api_gateway_resource":                     dataSourceAwsApiGatewayResource(),
+			"aws_api_gateway_rest_api":                     dataSourceAwsApiGatewayRestApi(),
+			"aws_api_gateway_vpc_link":                     dataSourceAwsApiGatewayVpcLink(),
+			"aws_arn":                                      dataSourceAwsArn(),
+			"aws_autoscaling_group":                        dataSourceAwsAutoscalingGroup(),
+			"aws_autoscaling_groups":                       dataSourceAwsAutoscalingGroups(),
+			"aws_availability_zone":                        dataSourceAwsAvailabilityZone(),
+			"aws_availability_zones":                       dataSourceAwsAvailabilityZones(),
+			"aws_batch_compute_environment":                dataSourceAwsBatchComputeEnvironment(),
+			"aws_batch_job_queue":                          dataSourceAwsBatchJobQueue(),
+			"aws_billing_service_account":                  dataSourceAwsBillingServiceAccount(),
+			"aws_caller_identity":                          dataSourceAwsCallerIdentity(),
+			"aws_canonical_user_id":                        dataSourceAwsCanonicalUserId(),
+			"aws_cloudformation_export":                    dataSourceAwsCloudFormationExport(),
+			"aws_cloudformation_stack":                     dataSourceAwsCloudFormationStack(),
+			"aws_cloudhsm_v2_cluster":                      dataSourceCloudHsm2Cluster(),
+			"aws_cloudtrail_service_account":               dataSourceAwsCloudTrailServiceAccount(),
+			"aws_cloudwatch_log_group":                     dataSourceAwsCloudwatchLogGroup(),
+			"aws_cloudwatch_log_groups":                    dataSourceAwsCloudwatchLogGroups(),
+			"aws_codecommit_repository":                    dataSourceAwsCodeCommitRepository(),
+			"aws_cognito_user_pools":                       dataSourceAwsCognitoUserPools(),
+			"aws_cur_report_definition":                    dataSourceAwsCurReportDefinition(),
+			"aws_db_cluster_snapshot":                      dataSourceAwsDbClusterSnapshot(),
+			"aws_db_event_categories":                      dataSourceAwsDbEventCategories(),
+			"aws_db_instance":                              dataSourceAwsDbInstance(),
+			"aws_db_snapshot":                              dataSourceAwsDbSnapshot(),
+			"aws_dx_gateway":                               dataSourceAwsDxGateway(),
+			"aws_dynamodb_table":                           dataSourceAwsDynamoDbTable(),
+			"aws_ebs_snapshot":                             dataSourceAwsEbsSnapshot(),
+			"aws_ebs_snapshot_ids":                         dataSourceAwsEbsSnapshotIds(),
+			"aws_ebs_volume":                               dataSourceAwsEbsVolume(),
+			"aws_ec2_transit_gateway":                      dataSourceAwsEc2TransitGateway(),
+			"aws_ec2_transit_gateway_route_table":          dataSourceAwsEc2TransitGatewayRouteTable(),
+			"aws_ec2_transit_gateway_vpc_attachment":       dataSourceAwsEc2TransitGatewayVpcAttachment(),
+			"aws_ec2_transit_gateway_vpn_attachment":       dataSourceAwsEc2TransitGatewayVpnAttachment(),
+			"aws_ecr_credentials":                          dataSourceAwsEcrCredentials(),
+			"aws_ecr_image":                                dataSourceAwsEcrImage(),
+			"aws_ecr_repository":                           dataSourceAwsEcrRepository(),
+			"aws_ecs_cluster":                              dataSourceAwsEcsCluster(),
+			"aws_ecs_container_definition":                 dataSourceAwsEcsContainerDefinition(),
+			"aws_ecs_service":                              dataSourceAwsEcsService(),
+			"aws_ecs_task_definition":                      dataSourceAwsEcsTaskDefinition(),
+			"aws_efs_file_system":                          dataSourceAwsEfsFileSystem(),
+			"aws_efs_mount_target":                         dataSourceAwsEfsMountTarget(),
+			"aws_eip":                                      dataSourceAwsEip(),
+			"aws_eks_cluster":                              dataSourceAwsEksCluster(),
+			"aws_eks_cluster_auth":                         dataSourceAwsEksClusterAuth(),
+			"aws_elastic_beanstalk_application":            dataSourceAwsElasticBeanstalkApplication(),
+			"aws_elastic_beanstalk_hosted_zone":            dataSourceAwsElasticBeanstalkHostedZone(),
+			"aws_elastic_beanstalk_solution_stack":         dataSourceAwsElasticBeanstalkSolutionStack(),
+			"aws_elasticache_cluster":                      dataSourceAwsElastiCacheCluster(),
+			"aws_elasticache_replication_group":            dataSourceAwsElasticacheReplicationGroup(),
+			"aws_elasticache_reserved_cache_node_offering": dataSourceAwsElasticacheReservedCacheNodeOffering(),
+			"aws_elb":                            dataSourceAwsElb(),
+			"aws_elb_hosted_zone_id":             dataSourceAwsElbHostedZoneId(),
+			"aws_elb_service_account":            dataSourceAwsElbServiceAccount(),
+			"aws_glue_script":                    dataSourceAwsGlueScript(),
+			"aws_iam_account_alias":              dataSourceAwsIamAccountAlias(),
+			"aws_iam_group":                      dataSourceAwsIAMGroup(),
+			"aws_iam_instance_profile":           dataSourceAwsIAMInstanceProfile(),
+			"aws_iam_policy":                     dataSourceAwsIAMPolicy(),
+			"aws_iam_policy_document":            dataSourceAwsIamPolicyDocument(),
+			"aws_iam_role":                       dataSourceAwsIAMRole(),
+			"aws_iam_server_certificate":         dataSourceAwsIAMServerCertificate(),
+			"aws_iam_user":                       dataSourceAwsIAMUser(),
+			"aws_inspector_rules_packages":       dataSourceAwsInspectorRulesPackages(),
+			"aws_instance":                       dataSourceAwsInstance(),
+			"aws_instances":                      dataSourceAwsInstances(),
+			"aws_internet_gateway":               dataSourceAwsInternetGateway(),
+			"aws_iot_endpoint":                   dataSourceAwsIotEndpoint(),
+			"aws_ip_ranges":                      dataSourceAwsIPRanges(),
+			"aws_kinesis_stream":                 dataSourceAwsKinesisStream(),
+			"aws_kms_alias":                      dataSourceAwsKmsAlias(),
+			"aws_kms_ciphertext":                 dataSourceAwsKmsCiphertext(),
+			"aws_kms_key":                        dataSourceAwsKmsKey(),
+			"aws_kms_secret":                     dataSourceAwsKmsSecret(),
+			"aws_kms_secrets":                    dataSourceAwsKmsSecrets(),
+			"aws_lambda_function":                dataSourceAwsLambdaFunction(),
+			"aws_lambda_invocation":              dataSourceAwsLambdaInvocation(),
+			"aws_lambda_layer_version":           dataSourceAwsLambdaLayerVersion(),
+			"aws_launch_configuration":           dataSourceAwsLaunchConfiguration(),
+			"aws_launch_template":                dataSourceAwsLaunchTemplate(),
+			"aws_mq_broker":                      dataSourceAwsMqBroker(),
+			"aws_msk_cluster":                    dataSourceAwsMskCluster(),
+			"aws_nat_gateway":                    dataSourceAwsNatGateway(),
+			"aws_network_acls":                   dataSourceAwsNetworkAcls(),
+			"aws_network_interface":              dataSourceAwsNetworkInterface(),
+			"aws_network_interfaces":             dataSourceAwsNetworkInterfaces(),
+			"aws_partition":                      dataSourceAwsPartition(),
+			"aws_prefix_list":                    dataSourceAwsPrefixList(),
+			"aws_pricing_product":                dataSourceAwsPricingProduct(),
+			"aws_ram_resource_share":             dataSourceAwsRamResourceShare(),
+			"aws_ram_resource_share_invitations": dataSourceAwsRamResourceShareInvitations(),
+			"aws_ram_resources":                  dataSourceAwsRamResources(),
+			"aws_rds_cluster":                    dataSourceAwsRdsCluster(),
+			"aws_rds_reserved_instance_offering": dataSourceAwsRdsReservedInstanceOffering(),
+			"aws_redshift_cluster":               dataSourceAwsRedshiftCluster(),
+			"aws_redshift_service_account":       dataSourceAwsRedshiftServiceAccount(),
+			"aws_region":                         dataSourceAwsRegion(),
+			"aws_resourcegroups_group_resources": dataSourceAwsResourceGroupsGroupResources(),
+			"aws_route":                          dataSourceAwsRoute(),
+			"aws_route53_delegation_set":         dataSourceAwsDelegationSet(),
+			"aws_route53_zone":                   dataSourceAwsRoute53Zone(),
+			"aws_route_table":                    dataSourceAwsRouteTable(),
+			"aws_route_tables":                   dataSourceAwsRouteTables(),
+			"aws_s3_account_public_access_block": dataSourceAwsS3AccountPublicAccessBlock(),
+			"aws_s3_bucket":                      dataSourceAwsS3Bucket(),
+			"aws_s3_bucket_object":               dataSourceAwsS3BucketObject(),
+			"aws_s3_bucket_objects":              dataSourceAwsS3BucketObjects(),
+			"aws_secretsmanager_secret":          dataSourceAwsSecretsManagerSecret(),
+			"aws_secretsmanager_secret_version":  dataSourceAwsSecretsManagerSecretVersion(),
+			"aws_security_group":                 dataSourceAwsSecurityGroup(),
+			"aws_security_groups":                dataSourceAwsSecurityGroups(),
+			"aws_ses_account":                    dataSourceAwsSesAccount(),
+			"aws_sns_topic":                      dataSourceAwsSnsTopic(),
+			"aws_sqs_queue":                      dataSourceAwsSqsQueue(),
+			"aws_ssm_document":                   dataSourceAwsSsmDocument(),
+			"aws_ssm_parameter":                  dataSourceAwsSsmParameter(),
+			"aws_ssm_parameters_by_path":         dataSourceAwsSsmParametersByPath(),
+			"aws_storagegateway_local_disk":      dataSourceAwsStorageGatewayLocalDisk(),
+			"aws_subnet":                         dataSourceAwsSubnet(),
+			"aws_subnet_ids":                     dataSourceAwsSubnetIDs(),
+			"aws_transfer_server":                dataSourceAwsTransferServer(),
+			"aws_vpc":                            dataSourceAwsVpc(),
+			"aws_vpc_dhcp_options":               dataSourceAwsVpcDhcpOptions(),
+			"aws_vpc_endpoint":                   dataSourceAwsVpcEndpoint(),
+			"aws_vpc_endpoint_service":           dataSourceAwsVpcEndpointService(),
+			"aws_vpc_peering_connection":         dataSourceAwsVpcPeeringConnection(),
+			"aws_vpcs":                           dataSourceAwsVpcs(),
+			"aws_vpn_gateway":                    dataSourceAwsVpnGateway(),
+			"aws_workspaces_bundle":              dataSourceAwsWorkspaceBundle(),
 
 			// Adding the Aliases for the ALB -> LB Rename
 			"aws_lb":               dataSourceAwsLb(),
@@ -278,9 +318,11 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"aws_acm_certificate":                                     resourceAwsAcmCertificate(),
-			"aws_acm_certificate_validation":                          resourceAwsAcmCertificateValidation(),
-			"aws_acmpca_certificate_authority":                        resourceAwsAcmpcaCertificateAuthority(),
+			"aws_acm_certificate":                          resourceAwsAcmCertificate(),
+			"aws_acm_certificate_validation":               resourceAwsAcmCertificateValidation(),
+			"aws_acmpca_certificate":                       resourceAwsAcmpcaCertificate(),
+			"aws_acmpca_certificate_authority":             resourceAwsAcmpcaCertificateAuthority(),
+			"aws_acmpca_certificate_authority_certificate": resourceAwsAcmpcaCertificateAuthorityCertificate(),
 			"aws_ami":                                                 resourceAwsAmi(),
 			"aws_ami_copy":                                            resourceAwsAmiCopy(),
 			"aws_ami_from_instance":                                   resourceAwsAmiFromInstance(),
@@ -308,6 +350,13 @@ func Provider() terraform.ResourceProvider {
 			"aws_api_gateway_usage_plan":                              resourceAwsApiGatewayUsagePlan(),
 			"aws_api_gateway_usage_plan_key":                          resourceAwsApiGatewayUsagePlanKey(),
 			"aws_api_gateway_vpc_link":                                resourceAwsApiGatewayVpcLink(),
+			"aws_apigatewayv2_api":                                    resourceAwsApiGatewayV2Api(),
+			"aws_apigatewayv2_api_mapping":                            resourceAwsApiGatewayV2ApiMapping(),
+			"aws_apigatewayv2_authorizer":                             resourceAwsApiGatewayV2Authorizer(),
+			"aws_apigatewayv2_domain_name":                            resourceAwsApiGatewayV2DomainName(),
+			"aws_apigatewayv2_integration":                            resourceAwsApiGatewayV2Integration(),
+			"aws_apigatewayv2_route":                                  resourceAwsApiGatewayV2Route(),
+			"aws_apigatewayv2_stage":                                  resourceAwsApiGatewayV2Stage(),
 			"aws_app_cookie_stickiness_policy":                        resourceAwsAppCookieStickinessPolicy(),
 			"aws_appautoscaling_target":                               resourceAwsAppautoscalingTarget(),
 			"aws_appautoscaling_policy":                               resourceAwsAppautoscalingPolicy(),
@@ -360,10 +409,12 @@ func Provider() terraform.ResourceProvider {
 			"aws_cognito_identity_pool":                               resourceAwsCognitoIdentityPool(),
 			"aws_cognito_identity_pool_roles_attachment":              resourceAwsCognitoIdentityPoolRolesAttachment(),
 			"aws_cognito_identity_provider":                           resourceAwsCognitoIdentityProvider(),
+			"aws_cognito_risk_configuration":                          resourceAwsCognitoRiskConfiguration(),
 			"aws_cognito_user_group":                                  resourceAwsCognitoUserGroup(),
 			"aws_cognito_user_pool":                                   resourceAwsCognitoUserPool(),
 			"aws_cognito_user_pool_client":                            resourceAwsCognitoUserPoolClient(),
 			"aws_cognito_user_pool_domain":                            resourceAwsCognitoUserPoolDomain(),
+			"aws_cognito_user_pool_ui_customization":                  resourceAwsCognitoUserPoolUICustomization(),
 			"aws_cloudhsm_v2_cluster":                                 resourceAwsCloudHsm2Cluster(),
 			"aws_cloudhsm_v2_hsm":                                     resourceAwsCloudHsm2Hsm(),
 			"aws_cognito_resource_server":                             resourceAwsCognitoResourceServer(),
@@ -400,6 +451,9 @@ func Provider() terraform.ResourceProvider {
 			"aws_devicefarm_project":                                  resourceAwsDevicefarmProject(),
 			"aws_directory_service_directory":                         resourceAwsDirectoryServiceDirectory(),
 			"aws_directory_service_conditional_forwarder":             resourceAwsDirectoryServiceConditionalForwarder(),
+			"aws_directory_service_shared_directory":                  resourceAwsDirectoryServiceSharedDirectory(),
+			"aws_directory_service_shared_directory_accepter":         resourceAwsDirectoryServiceSharedDirectoryAccepter(),
+			"aws_directory_service_trust":                             resourceAwsDirectoryServiceTrust(),
 			"aws_dlm_lifecycle_policy":                                resourceAwsDlmLifecyclePolicy(),
 			"aws_dms_certificate":                                     resourceAwsDmsCertificate(),
 			"aws_dms_endpoint":                                        resourceAwsDmsEndpoint(),
@@ -455,6 +509,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_elasticache_cluster":                                 resourceAwsElasticacheCluster(),
 			"aws_elasticache_parameter_group":                         resourceAwsElasticacheParameterGroup(),
 			"aws_elasticache_replication_group":                       resourceAwsElasticacheReplicationGroup(),
+			"aws_elasticache_reserved_cache_node":                     resourceAwsElasticacheReservedCacheNode(),
 			"aws_elasticache_security_group":                          resourceAwsElasticacheSecurityGroup(),
 			"aws_elasticache_subnet_group":                            resourceAwsElasticacheSubnetGroup(),
 			"aws_elastic_beanstalk_application":                       resourceAwsElasticBeanstalkApplication(),
@@ -546,9 +601,11 @@ func Provider() terraform.ResourceProvider {
 			"aws_launch_template":                                     resourceAwsLaunchTemplate(),
 			"aws_licensemanager_association":                          resourceAwsLicenseManagerAssociation(),
 			"aws_licensemanager_license_configuration":                resourceAwsLicenseManagerLicenseConfiguration(),
+			"aws_lightsail_database":                                  resourceAwsLightsailDatabase(),
 			"aws_lightsail_domain":                                    resourceAwsLightsailDomain(),
 			"aws_lightsail_instance":                                  resourceAwsLightsailInstance(),
 			"aws_lightsail_key_pair":                                  resourceAwsLightsailKeyPair(),
+			"aws_lightsail_lb":                                        resourceAwsLightsailLb(),
 			"aws_lightsail_static_ip":                                 resourceAwsLightsailStaticIp(),
 			"aws_lightsail_static_ip_attachment":                      resourceAwsLightsailStaticIpAttachment(),
 			"aws_lb_cookie_stickiness_policy":                         resourceAwsLBCookieStickinessPolicy(),
@@ -561,6 +618,9 @@ func Provider() terraform.ResourceProvider {
 			"aws_main_route_table_association":                        resourceAwsMainRouteTableAssociation(),
 			"aws_mq_broker":                                           resourceAwsMqBroker(),
 			"aws_mq_configuration":                                    resourceAwsMqConfiguration(),
+			"aws_media_convert_job_template":                          resourceAwsMediaConvertJobTemplate(),
+			"aws_media_convert_preset":                                resourceAwsMediaConvertPreset(),
+			"aws_media_convert_queue":                                 resourceAwsMediaConvertQueue(),
 			"aws_media_package_channel":                               resourceAwsMediaPackageChannel(),
 			"aws_media_store_container":                               resourceAwsMediaStoreContainer(),
 			"aws_media_store_container_policy":                        resourceAwsMediaStoreContainerPolicy(),
@@ -602,14 +662,19 @@ func Provider() terraform.ResourceProvider {
 			"aws_organizations_organizational_unit":                   resourceAwsOrganizationsOrganizationalUnit(),
 			"aws_placement_group":                                     resourceAwsPlacementGroup(),
 			"aws_proxy_protocol_policy":                               resourceAwsProxyProtocolPolicy(),
+			"aws_quicksight_group":                                    resourceAwsQuickSightGroup(),
+			"aws_quicksight_group_membership":                         resourceAwsQuickSightGroupMembership(),
+			"aws_quicksight_user":                                     resourceAwsQuickSightUser(),
 			"aws_ram_principal_association":                           resourceAwsRamPrincipalAssociation(),
 			"aws_ram_resource_association":                            resourceAwsRamResourceAssociation(),
 			"aws_ram_resource_share":                                  resourceAwsRamResourceShare(),
+			"aws_ram_resource_share_accepter":                         resourceAwsRamResourceShareAccepter(),
 			"aws_rds_cluster":                                         resourceAwsRDSCluster(),
 			"aws_rds_cluster_endpoint":                                resourceAwsRDSClusterEndpoint(),
 			"aws_rds_cluster_instance":                                resourceAwsRDSClusterInstance(),
 			"aws_rds_cluster_parameter_group":                         resourceAwsRDSClusterParameterGroup(),
 			"aws_rds_global_cluster":                                  resourceAwsRDSGlobalCluster(),
+			"aws_rds_reserved_instance":                               resourceAwsRdsReservedInstance(),
 			"aws_redshift_cluster":                                    resourceAwsRedshiftCluster(),
 			"aws_redshift_security_group":                             resourceAwsRedshiftSecurityGroup(),
 			"aws_redshift_parameter_group":                            resourceAwsRedshiftParameterGroup(),
@@ -636,7 +701,9 @@ func Provider() terraform.ResourceProvider {
 			"aws_sagemaker_notebook_instance_lifecycle_configuration": resourceAwsSagemakerNotebookInstanceLifeCycleConfiguration(),
 			"aws_sagemaker_notebook_instance":                         resourceAwsSagemakerNotebookInstance(),
 			"aws_secretsmanager_secret":                               resourceAwsSecretsManagerSecret(),
+			"aws_secretsmanager_secret_rotation":                      resourceAwsSecretsManagerSecretRotation(),
 			"aws_secretsmanager_secret_version":                       resourceAwsSecretsManagerSecretVersion(),
+			"aws_ses_account_sending":                                 resourceAwsSesAccountSending(),
 			"aws_ses_active_receipt_rule_set":                         resourceAwsSesActiveReceiptRuleSet(),
 			"aws_ses_domain_identity":                                 resourceAwsSesDomainIdentity(),
 			"aws_ses_domain_identity_verification":                    resourceAwsSesDomainIdentityVerification(),
@@ -658,6 +725,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_s3_bucket_notification":                              resourceAwsS3BucketNotification(),
 			"aws_s3_bucket_metric":                                    resourceAwsS3BucketMetric(),
 			"aws_s3_bucket_inventory":                                 resourceAwsS3BucketInventory(),
+			"aws_s3_object_copy":                                      resourceAwsS3ObjectCopy(),
 			"aws_security_group":                                      resourceAwsSecurityGroup(),
 			"aws_network_interface_sg_attachment":                     resourceAwsNetworkInterfaceSGAttachment(),
 			"aws_default_security_group":                              resourceAwsDefaultSecurityGroup(),
@@ -682,6 +750,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_ssm_patch_group":                                     resourceAwsSsmPatchGroup(),
 			"aws_ssm_parameter":                                       resourceAwsSsmParameter(),
 			"aws_ssm_resource_data_sync":                              resourceAwsSsmResourceDataSync(),
+			"aws_ssm_session_manager_preferences":                     resourceAwsSsmSessionManagerPreferences(),
 			"aws_storagegateway_cache":                                resourceAwsStorageGatewayCache(),
 			"aws_storagegateway_cached_iscsi_volume":                  resourceAwsStorageGatewayCachedIscsiVolume(),
 			"aws_storagegateway_gateway":                              resourceAwsStorageGatewayGateway(),
@@ -704,7 +773,9 @@ func Provider() terraform.ResourceProvider {
 			"aws_sfn_state_machine":                                   resourceAwsSfnStateMachine(),
 			"aws_default_subnet":                                      resourceAwsDefaultSubnet(),
 			"aws_subnet":                                              resourceAwsSubnet(),
+			"aws_swf_activity_type":                                   resourceAwsSwfActivityType(),
 			"aws_swf_domain":                                          resourceAwsSwfDomain(),
+			"aws_swf_workflow_type":                                   resourceAwsSwfWorkflowType(),
 			"aws_transfer_server":                                     resourceAwsTransferServer(),
 			"aws_transfer_ssh_key":                                    resourceAwsTransferSshKey(),
 			"aws_transfer_user":                                       resourceAwsTransferUser(),
@@ -719,6 +790,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_vpc":                                                 resourceAwsVpc(),
 			"aws_vpc_endpoint":                                        resourceAwsVpcEndpoint(),
 			"aws_vpc_endpoint_connection_notification":                resourceAwsVpcEndpointConnectionNotification(),
+			"aws_vpc_endpoint_policy":                                 resourceAwsVpcEndpointPolicy(),
 			"aws_vpc_endpoint_route_table_association":                resourceAwsVpcEndpointRouteTableAssociation(),
 			"aws_vpc_endpoint_subnet_association":                     resourceAwsVpcEndpointSubnetAssociation(),
 			"aws_vpc_endpoint_service":                                resourceAwsVpcEndpointService(),
@@ -794,7 +866,6 @@ func Provider() terraform.ResourceProvider {
 }
 
 var descriptions map[string]string
-var endpointServiceNames []string
 
 func init() {
 	descriptions = map[string]string{
@@ -820,6 +891,22 @@ func init() {
 			"being executed. If the API request still fails, an error is\n" +
 			"thrown.",
 
+		"eventual_consistency_timeout": "How long to retry requests that fail because a\n" +
+			"recently created or modified IAM role or KMS key has not yet propagated,\n" +
+			"as a duration such as `2m`.",
+
+		"retry_mode": "Specifies how client-side request rate limits behave.\n" +
+			"Valid values are `standard` (fixed rates) and `adaptive` (rates back off\n" +
+			"on throttling and recover on success, with default limits for Route 53,\n" +
+			"CloudFront and Organizations).",
+
+		"service_limits_service": "The service to configure, using the same name as in the `endpoints` block.",
+
+		"service_limits_max_retries": "Overrides `max_retries` for API requests to the service.",
+
+		"service_limits_requests_per_second": "The maximum number of API requests per second\n" +
+			"sent to the service by this provider.",
+
 		"endpoint": "Use this to override the default service endpoint URL",
 
 		"insecure": "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted," +
@@ -857,125 +944,6 @@ func init() {
 			" this policy to grant further permissions that are in excess to those of the, " +
 			" role that is being assumed.",
 	}
-
-	endpointServiceNames = []string{
-		"acm",
-		"acmpca",
-		"apigateway",
-		"applicationautoscaling",
-		"appmesh",
-		"appsync",
-		"athena",
-		"autoscaling",
-		"backup",
-		"batch",
-		"budgets",
-		"cloud9",
-		"cloudformation",
-		"cloudfront",
-		"cloudhsm",
-		"cloudsearch",
-		"cloudtrail",
-		"cloudwatch",
-		"cloudwatchevents",
-		"cloudwatchlogs",
-		"codebuild",
-		"codecommit",
-		"codedeploy",
-		"codepipeline",
-		"cognitoidentity",
-		"cognitoidp",
-		"configservice",
-		"cur",
-		"datapipeline",
-		"datasync",
-		"dax",
-		"devicefarm",
-		"directconnect",
-		"dlm",
-		"dms",
-		"docdb",
-		"ds",
-		"dynamodb",
-		"ec2",
-		"ecr",
-		"ecs",
-		"efs",
-		"eks",
-		"elasticache",
-		"elasticbeanstalk",
-		"elastictranscoder",
-		"elb",
-		"emr",
-		"es",
-		"firehose",
-		"fms",
-		"fsx",
-		"gamelift",
-		"glacier",
-		"globalaccelerator",
-		"glue",
-		"guardduty",
-		"iam",
-		"inspector",
-		"iot",
-		"kafka",
-		"kinesis_analytics",
-		"kinesis",
-		"kinesisanalytics",
-		"kinesisvideo",
-		"kms",
-		"lambda",
-		"lexmodels",
-		"licensemanager",
-		"lightsail",
-		"macie",
-		"managedblockchain",
-		"mediaconnect",
-		"mediaconvert",
-		"medialive",
-		"mediapackage",
-		"mediastore",
-		"mediastoredata",
-		"mq",
-		"neptune",
-		"opsworks",
-		"organizations",
-		"pinpoint",
-		"pricing",
-		"quicksight",
-		"r53",
-		"ram",
-		"rds",
-		"redshift",
-		"resourcegroups",
-		"route53",
-		"route53resolver",
-		"s3",
-		"s3control",
-		"sagemaker",
-		"sdb",
-		"secretsmanager",
-		"securityhub",
-		"serverlessrepo",
-		"servicecatalog",
-		"servicediscovery",
-		"ses",
-		"shield",
-		"sns",
-		"sqs",
-		"ssm",
-		"stepfunctions",
-		"storagegateway",
-		"sts",
-		"swf",
-		"transfer",
-		"waf",
-		"wafregional",
-		"worklink",
-		"workspaces",
-		"xray",
-	}
 }
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
@@ -994,6 +962,7 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		SkipRequestingAccountId: d.Get("skip_requesting_account_id").(bool),
 		SkipMetadataApiCheck:    d.Get("skip_metadata_api_check").(bool),
 		S3ForcePathStyle:        d.Get("s3_force_path_style").(bool),
+		LogAWSRequests:          os.Getenv("TF_LOG_AWS") != "",
 	}
 
 	// Set CredsFilename, expanding home directory
@@ -1029,6 +998,34 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		}
 	}
 
+	eventualConsistencyTimeout, err := time.ParseDuration(d.Get("eventual_consistency_timeout").(string))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing eventual_consistency_timeout: %s", err)
+	}
+	config.EventualConsistencyTimeout = eventualConsistencyTimeout
+
+	config.RetryMode = d.Get("retry_mode").(string)
+	config.ServiceLimits = make(map[string]*ServiceLimit)
+
+	for i, serviceLimitI := range d.Get("service_limits").([]interface{}) {
+		serviceLimit := serviceLimitI.(map[string]interface{})
+		service := serviceLimit["service"].(string)
+
+		if _, ok := config.ServiceLimits[service]; ok {
+			return nil, fmt.Errorf("service_limits: duplicate configuration for service %q", service)
+		}
+
+		config.ServiceLimits[service] = &ServiceLimit{
+			RequestsPerSecond: serviceLimit["requests_per_second"].(float64),
+		}
+
+		// max_retries = 0 disables retries, so it is distinguished from unset.
+		if v, ok := d.GetOkExists(fmt.Sprintf("service_limits.%d.max_retries", i)); ok {
+			maxRetries := v.(int)
+			config.ServiceLimits[service].MaxRetries = &maxRetries
+		}
+	}
+
 	if v, ok := d.GetOk("allowed_account_ids"); ok {
 		for _, accountIDRaw := range v.(*schema.Set).List() {
 			config.AllowedAccountIds = append(config.AllowedAccountIds, accountIDRaw.(string))
@@ -1082,6 +1079,37 @@ func assumeRoleSchema() *schema.Schema {
 	}
 }
 
+func serviceLimitsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"service": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  descriptions["service_limits_service"],
+					ValidateFunc: validation.StringInSlice(endpointServiceNames, false),
+				},
+
+				"max_retries": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  descriptions["service_limits_max_retries"],
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+
+				"requests_per_second": {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					Description:  descriptions["service_limits_requests_per_second"],
+					ValidateFunc: validation.FloatBetween(requestRateLimiterMinRequestsPerSecond, 10000),
+				},
+			},
+		},
+	}
+}
+
 func endpointsSchema() *schema.Schema {
 	endpointsAttributes := make(map[string]*schema.Schema)
 
@@ -0,0 +1,142 @@
+package aws
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+var updateSchemaSnapshots = flag.Bool("update-schema-snapshots", false, "regenerate the provider schema snapshot files")
+
+const schemaSnapshotsDir = "test-fixtures/schema-snapshots"
+
+// TestProviderSchemaSnapshots compares each resource and data source schema against
+// its snapshot file, so that unintended schema changes (e.g. attribute type changes
+// or ForceNew being added) are caught before release. Intentional changes are
+// recorded by running make schema-snapshots.
+func TestProviderSchemaSnapshots(t *testing.T) {
+	provider := Provider().(*schema.Provider)
+
+	testProviderSchemaSnapshots(t, "data-source", provider.DataSourcesMap)
+	testProviderSchemaSnapshots(t, "resource", provider.ResourcesMap)
+}
+
+func testProviderSchemaSnapshots(t *testing.T, kind string, resources map[string]*schema.Resource) {
+	dir := filepath.Join(schemaSnapshotsDir, kind)
+
+	if *updateSchemaSnapshots {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatalf("error removing %s schema snapshots: %s", kind, err)
+		}
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatalf("error creating %s schema snapshots directory: %s", kind, err)
+		}
+	}
+
+	for name, r := range resources {
+		path := filepath.Join(dir, name+".txt")
+		got := schemaSnapshot(r.Schema)
+
+		if *updateSchemaSnapshots {
+			if err := ioutil.WriteFile(path, []byte(got), 0644); err != nil {
+				t.Errorf("error writing %s %s schema snapshot: %s", kind, name, err)
+			}
+			continue
+		}
+
+		want, err := ioutil.ReadFile(path)
+		if os.IsNotExist(err) {
+			t.Errorf("%s %s has no schema snapshot, regenerate snapshots with -update-schema-snapshots", kind, name)
+			continue
+		}
+		if err != nil {
+			t.Errorf("error reading %s %s schema snapshot: %s", kind, name, err)
+			continue
+		}
+
+		if got != string(want) {
+			t.Errorf("%s %s schema does not match its snapshot, ensure the change is intentional and listed in the CHANGELOG, then regenerate snapshots with -update-schema-snapshots\n\nexpected:\n%s\ngot:\n%s", kind, name, want, got)
+		}
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.txt"))
+	if err != nil {
+		t.Fatalf("error listing %s schema snapshots: %s", kind, err)
+	}
+
+	for _, file := range files {
+		name := strings.TrimSuffix(filepath.Base(file), ".txt")
+		if _, ok := resources[name]; !ok {
+			t.Errorf("schema snapshot %s has no matching %s, regenerate snapshots with -update-schema-snapshots", file, kind)
+		}
+	}
+}
+
+// schemaSnapshot returns one line per attribute, including nested block attributes,
+// sorted by attribute path.
+func schemaSnapshot(m map[string]*schema.Schema) string {
+	var lines []string
+	schemaSnapshotLines(&lines, "", m)
+
+	// Sort on the attribute path alone so blocks precede their nested attributes.
+	sort.Slice(lines, func(i, j int) bool {
+		return strings.SplitN(lines[i], ":", 2)[0] < strings.SplitN(lines[j], ":", 2)[0]
+	})
+
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func schemaSnapshotLines(lines *[]string, prefix string, m map[string]*schema.Schema) {
+	for k, s := range m {
+		path := prefix + k
+		fields := []string{path + ":", s.Type.String()}
+
+		if s.Required {
+			fields = append(fields, "Required")
+		}
+		if s.Optional {
+			fields = append(fields, "Optional")
+		}
+		if s.Computed {
+			fields = append(fields, "Computed")
+		}
+		if s.ForceNew {
+			fields = append(fields, "ForceNew")
+		}
+		if s.Sensitive {
+			fields = append(fields, "Sensitive")
+		}
+		if s.Deprecated != "" {
+			fields = append(fields, "Deprecated")
+		}
+		if s.Removed != "" {
+			fields = append(fields, "Removed")
+		}
+		if s.MinItems > 0 {
+			fields = append(fields, fmt.Sprintf("MinItems=%d", s.MinItems))
+		}
+		if s.MaxItems > 0 {
+			fields = append(fields, fmt.Sprintf("MaxItems=%d", s.MaxItems))
+		}
+		if s.Default != nil {
+			fields = append(fields, fmt.Sprintf("Default=%#v", s.Default))
+		}
+
+		switch elem := s.Elem.(type) {
+		case *schema.Schema:
+			fields = append(fields, "Elem="+elem.Type.String())
+		case *schema.Resource:
+			fields = append(fields, "Elem=Block")
+			schemaSnapshotLines(lines, path+".", elem.Schema)
+		}
+
+		*lines = append(*lines, strings.Join(fields, " "))
+	}
+}
@@ -65,6 +65,35 @@ func TestProvider_impl(t *testing.T) {
 	var _ terraform.ResourceProvider = Provider()
 }
 
+func TestProvider_eventualConsistencyTimeoutValidation(t *testing.T) {
+	validate := Provider().(*schema.Provider).Schema["eventual_consistency_timeout"].ValidateFunc
+
+	testCases := []struct {
+		Value         string
+		ExpectedError bool
+	}{
+		{Value: "30s"},
+		{Value: "5m"},
+		{Value: "0s", ExpectedError: true},
+		{Value: "-1m", ExpectedError: true},
+		{Value: "five minutes", ExpectedError: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Value, func(t *testing.T) {
+			_, errors := validate(testCase.Value, "eventual_consistency_timeout")
+
+			if testCase.ExpectedError && len(errors) == 0 {
+				t.Fatal("expected error")
+			}
+
+			if !testCase.ExpectedError && len(errors) > 0 {
+				t.Fatalf("unexpected errors: %v", errors)
+			}
+		})
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	if os.Getenv("AWS_PROFILE") == "" && os.Getenv("AWS_ACCESS_KEY_ID") == "" {
 		t.Fatal("AWS_ACCESS_KEY_ID or AWS_PROFILE must be set for acceptance tests")
@@ -144,6 +173,39 @@ func testAccMatchResourceAttrRegionalARN(resourceName, attributeName, arnService
 	}
 }
 
+// testAccCheckResourceAttrRegionalARNNoAccount ensures the Terraform state exactly matches a formatted ARN with region but without account ID
+func testAccCheckResourceAttrRegionalARNNoAccount(resourceName, attributeName, arnService, arnResource string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		attributeValue := arn.ARN{
+			Partition: testAccGetPartition(),
+			Region:    testAccGetRegion(),
+			Resource:  arnResource,
+			Service:   arnService,
+		}.String()
+		return resource.TestCheckResourceAttr(resourceName, attributeName, attributeValue)(s)
+	}
+}
+
+// testAccMatchResourceAttrRegionalARNNoAccount ensures the Terraform state regexp matches a formatted ARN with region but without account ID
+func testAccMatchResourceAttrRegionalARNNoAccount(resourceName, attributeName, arnService string, arnResourceRegexp *regexp.Regexp) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		arnRegexp := arn.ARN{
+			Partition: testAccGetPartition(),
+			Region:    testAccGetRegion(),
+			Resource:  arnResourceRegexp.String(),
+			Service:   arnService,
+		}.String()
+
+		attributeMatch, err := regexp.Compile(arnRegexp)
+
+		if err != nil {
+			return fmt.Errorf("Unable to compile ARN regexp (%s): %s", arnRegexp, err)
+		}
+
+		return resource.TestMatchResourceAttr(resourceName, attributeName, attributeMatch)(s)
+	}
+}
+
 // testAccCheckResourceAttrGlobalARN ensures the Terraform state exactly matches a formatted ARN without region
 func testAccCheckResourceAttrGlobalARN(resourceName, attributeName, arnService, arnResource string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -198,6 +260,26 @@ func testAccGetPartition() string {
 	return "aws"
 }
 
+// testAccGetAlternateRegion returns the region used for cross-region acceptance testing.
+// It defaults to us-east-1, or us-west-2 when us-east-1 is the primary testing region.
+func testAccGetAlternateRegion() string {
+	v := os.Getenv("AWS_ALTERNATE_REGION")
+	if v != "" {
+		return v
+	}
+	if testAccGetRegion() == "us-east-1" {
+		return "us-west-2"
+	}
+	return "us-east-1"
+}
+
+func testAccGetAlternateRegionPartition() string {
+	if partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), testAccGetAlternateRegion()); ok {
+		return partition.ID()
+	}
+	return "aws"
+}
+
 func testAccAlternateAccountPreCheck(t *testing.T) {
 	if os.Getenv("AWS_ALTERNATE_PROFILE") == "" && os.Getenv("AWS_ALTERNATE_ACCESS_KEY_ID") == "" {
 		t.Fatal("AWS_ALTERNATE_ACCESS_KEY_ID or AWS_ALTERNATE_PROFILE must be set for acceptance tests")
@@ -208,6 +290,28 @@ func testAccAlternateAccountPreCheck(t *testing.T) {
 	}
 }
 
+// testAccAlternateRegionPreCheck ensures the alternate region differs from the primary
+// testing region while staying within the same partition, as cross-region features
+// such as peering and replication cannot span partitions.
+func testAccAlternateRegionPreCheck(t *testing.T) {
+	testAccMultipleRegionsPreCheck(t)
+
+	if testAccGetRegion() == testAccGetAlternateRegion() {
+		t.Fatal("AWS_DEFAULT_REGION and AWS_ALTERNATE_REGION must be set to different values for acceptance tests")
+	}
+
+	if testAccGetPartition() != testAccGetAlternateRegionPartition() {
+		t.Fatalf("AWS_ALTERNATE_REGION partition (%s) does not match AWS_DEFAULT_REGION partition (%s)", testAccGetAlternateRegionPartition(), testAccGetPartition())
+	}
+}
+
+// testAccPartitionPreCheck skips the test when the primary testing region is not in the given partition.
+func testAccPartitionPreCheck(partition string, t *testing.T) {
+	if testAccGetPartition() != partition {
+		t.Skipf("skipping tests; current partition (%s) does not equal %s", testAccGetPartition(), partition)
+	}
+}
+
 func testAccEC2ClassicPreCheck(t *testing.T) {
 	client := testAccProvider.Meta().(*AWSClient)
 	platforms := client.supportedplatforms
@@ -258,6 +362,31 @@ provider "aws" {
 `, os.Getenv("AWS_ALTERNATE_ACCESS_KEY_ID"), os.Getenv("AWS_ALTERNATE_PROFILE"), os.Getenv("AWS_ALTERNATE_SECRET_ACCESS_KEY"))
 }
 
+// testAccAlternateAccountAlternateRegionProviderConfig returns the aws.alternate provider
+// configured with the alternate account credentials in the alternate region.
+func testAccAlternateAccountAlternateRegionProviderConfig() string {
+	return fmt.Sprintf(`
+provider "aws" {
+  access_key = %[1]q
+  alias      = "alternate"
+  profile    = %[2]q
+  region     = %[3]q
+  secret_key = %[4]q
+}
+`, os.Getenv("AWS_ALTERNATE_ACCESS_KEY_ID"), os.Getenv("AWS_ALTERNATE_PROFILE"), testAccGetAlternateRegion(), os.Getenv("AWS_ALTERNATE_SECRET_ACCESS_KEY"))
+}
+
+// testAccAlternateRegionProviderConfig returns the aws.alternate provider
+// configured with the default credentials in the alternate region.
+func testAccAlternateRegionProviderConfig() string {
+	return fmt.Sprintf(`
+provider "aws" {
+  alias  = "alternate"
+  region = %[1]q
+}
+`, testAccGetAlternateRegion())
+}
+
 // Provider configuration hardcoded for us-east-1.
 // This should only be necessary for testing ACM Certificates with CloudFront
 // related infrastucture such as API Gateway Domain Names for EDGE endpoints,
@@ -312,6 +441,37 @@ func testAccAwsRegionProviderFunc(region string, providers *[]*schema.Provider)
 	}
 }
 
+// testAccAlternateRegionProviderFunc returns the configured provider for the alternate region.
+func testAccAlternateRegionProviderFunc(providers *[]*schema.Provider) func() *schema.Provider {
+	return testAccAwsRegionProviderFunc(testAccGetAlternateRegion(), providers)
+}
+
+// testAccAlternateAccountProviderFunc returns the first configured AWS provider
+// whose account ID differs from the account ID of testAccProvider.
+func testAccAlternateAccountProviderFunc(providers *[]*schema.Provider) func() *schema.Provider {
+	return func() *schema.Provider {
+		if providers == nil {
+			log.Println("[DEBUG] No providers given")
+			return nil
+		}
+
+		accountID := testAccGetAccountID()
+
+		log.Printf("[DEBUG] Checking providers for AWS account other than: %s", accountID)
+		for _, provider := range *providers {
+			providerAccountID := testAccAwsProviderAccountID(provider)
+
+			if providerAccountID != "" && providerAccountID != accountID {
+				log.Printf("[DEBUG] Found AWS provider with account ID: %s", providerAccountID)
+				return provider
+			}
+		}
+
+		log.Printf("[DEBUG] No suitable provider found for alternate account in %d providers", len(*providers))
+		return nil
+	}
+}
+
 func testAccCheckWithProviders(f func(*terraform.State, *schema.Provider) error, providers *[]*schema.Provider) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		numberOfProviders := len(*providers)
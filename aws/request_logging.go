@@ -0,0 +1,64 @@
+package aws
+
+import (
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// awsRequestLogEntry is the structured record logged for each completed AWS API call
+// when TF_LOG_AWS is set, so failures can be correlated with CloudTrail and AWS Support.
+type awsRequestLogEntry struct {
+	Service    string `json:"service"`
+	Operation  string `json:"operation"`
+	Region     string `json:"region,omitempty"`
+	RequestID  string `json:"request_id,omitempty"`
+	StatusCode int    `json:"status_code,omitempty"`
+	LatencyMs  int64  `json:"latency_ms"`
+	RetryCount int    `json:"retry_count"`
+	ErrorCode  string `json:"error_code,omitempty"`
+}
+
+// logAwsRequestHandler is a Complete handler, run once per API call after all retries.
+func logAwsRequestHandler(r *request.Request) {
+	entry := newAwsRequestLogEntry(r, time.Now())
+
+	b, err := json.Marshal(entry)
+	if err != nil {
+		log.Printf("[WARN] error marshalling AWS API call log entry: %s", err)
+		return
+	}
+
+	log.Printf("[DEBUG] AWS API call: %s", b)
+}
+
+func newAwsRequestLogEntry(r *request.Request, completed time.Time) *awsRequestLogEntry {
+	entry := &awsRequestLogEntry{
+		Service:    r.ClientInfo.ServiceName,
+		Region:     aws.StringValue(r.Config.Region),
+		RequestID:  r.RequestID,
+		RetryCount: r.RetryCount,
+	}
+
+	if r.Operation != nil {
+		entry.Operation = r.Operation.Name
+	}
+
+	if !r.Time.IsZero() {
+		entry.LatencyMs = int64(completed.Sub(r.Time) / time.Millisecond)
+	}
+
+	if r.HTTPResponse != nil {
+		entry.StatusCode = r.HTTPResponse.StatusCode
+	}
+
+	if awsErr, ok := r.Error.(awserr.Error); ok {
+		entry.ErrorCode = awsErr.Code()
+	}
+
+	return entry
+}
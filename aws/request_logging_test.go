@@ -0,0 +1,89 @@
+package aws
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+func TestNewAwsRequestLogEntry(t *testing.T) {
+	started := time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name    string
+		request *request.Request
+		want    *awsRequestLogEntry
+	}{
+		{
+			name: "success",
+			request: &request.Request{
+				ClientInfo:   metadata.ClientInfo{ServiceName: "ec2"},
+				Config:       aws.Config{Region: aws.String("us-west-2")},
+				HTTPResponse: &http.Response{StatusCode: 200},
+				Operation:    &request.Operation{Name: "DescribeVpcs"},
+				RequestID:    "request-1",
+				Time:         started,
+			},
+			want: &awsRequestLogEntry{
+				Service:    "ec2",
+				Operation:  "DescribeVpcs",
+				Region:     "us-west-2",
+				RequestID:  "request-1",
+				StatusCode: 200,
+				LatencyMs:  1500,
+			},
+		},
+		{
+			name: "retried AWS error",
+			request: &request.Request{
+				ClientInfo:   metadata.ClientInfo{ServiceName: "dynamodb"},
+				Config:       aws.Config{Region: aws.String("eu-west-1")},
+				Error:        awserr.New("ThrottlingException", "Rate exceeded", nil),
+				HTTPResponse: &http.Response{StatusCode: 400},
+				Operation:    &request.Operation{Name: "DescribeTable"},
+				RequestID:    "request-2",
+				RetryCount:   3,
+				Time:         started,
+			},
+			want: &awsRequestLogEntry{
+				Service:    "dynamodb",
+				Operation:  "DescribeTable",
+				Region:     "eu-west-1",
+				RequestID:  "request-2",
+				StatusCode: 400,
+				LatencyMs:  1500,
+				RetryCount: 3,
+				ErrorCode:  "ThrottlingException",
+			},
+		},
+		{
+			name: "no response",
+			request: &request.Request{
+				ClientInfo: metadata.ClientInfo{ServiceName: "s3"},
+				Error:      errors.New("connection refused"),
+				Operation:  &request.Operation{Name: "ListBuckets"},
+			},
+			want: &awsRequestLogEntry{
+				Service:   "s3",
+				Operation: "ListBuckets",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := newAwsRequestLogEntry(testCase.request, started.Add(1500*time.Millisecond))
+
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("got %#v, expected %#v", got, testCase.want)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -22,8 +23,16 @@ func resourceAwsAcmCertificate() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceAwsAcmCertificateCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
+			"certificate_authority_arn": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validateArn,
+				ConflictsWith: []string{"private_key", "certificate_body", "certificate_chain", "validation_method"},
+			},
 			"certificate_body": {
 				Type:      schema.TypeString,
 				Optional:  true,
@@ -73,15 +82,47 @@ func resourceAwsAcmCertificate() *schema.Resource {
 				Optional:      true,
 				Computed:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"private_key", "certificate_body", "certificate_chain"},
+				ConflictsWith: []string{"private_key", "certificate_body", "certificate_chain", "certificate_authority_arn"},
+			},
+			"early_renewal_duration": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"private_key", "certificate_body", "certificate_chain", "validation_method"},
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					duration, err := time.ParseDuration(v.(string))
+					if err != nil {
+						errors = append(errors, fmt.Errorf("%q cannot be parsed as a duration: %s", k, err))
+					}
+					if duration <= 0 {
+						errors = append(errors, fmt.Errorf("%q must be greater than zero", k))
+					}
+					return
+				},
+			},
+			"not_after": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"not_before": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"pending_renewal": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"renewal_eligibility": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 			"domain_validation_options": {
-				Type:     schema.TypeList,
+				Type:     schema.TypeSet,
 				Computed: true,
+				Set:      acmDomainValidationOptionsHash,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"domain_name": {
@@ -115,8 +156,10 @@ func resourceAwsAcmCertificate() *schema.Resource {
 
 func resourceAwsAcmCertificateCreate(d *schema.ResourceData, meta interface{}) error {
 	if _, ok := d.GetOk("domain_name"); ok {
-		if _, ok := d.GetOk("validation_method"); !ok {
-			return errors.New("validation_method must be set when creating a certificate")
+		_, hasValidationMethod := d.GetOk("validation_method")
+		_, hasCertificateAuthorityArn := d.GetOk("certificate_authority_arn")
+		if !hasValidationMethod && !hasCertificateAuthorityArn {
+			return errors.New("validation_method or certificate_authority_arn must be set when creating a certificate")
 		}
 		return resourceAwsAcmCertificateCreateRequested(d, meta)
 	} else if _, ok := d.GetOk("private_key"); ok {
@@ -154,8 +197,14 @@ func resourceAwsAcmCertificateCreateImported(d *schema.ResourceData, meta interf
 func resourceAwsAcmCertificateCreateRequested(d *schema.ResourceData, meta interface{}) error {
 	acmconn := meta.(*AWSClient).acmconn
 	params := &acm.RequestCertificateInput{
-		DomainName:       aws.String(strings.TrimSuffix(d.Get("domain_name").(string), ".")),
-		ValidationMethod: aws.String(d.Get("validation_method").(string)),
+		DomainName: aws.String(strings.TrimSuffix(d.Get("domain_name").(string), ".")),
+	}
+
+	// Private certificates are issued by the certificate authority and need no validation
+	if v, ok := d.GetOk("certificate_authority_arn"); ok {
+		params.CertificateAuthorityArn = aws.String(v.(string))
+	} else {
+		params.ValidationMethod = aws.String(d.Get("validation_method").(string))
 	}
 
 	if sans, ok := d.GetOk("subject_alternative_names"); ok {
@@ -209,6 +258,24 @@ func resourceAwsAcmCertificateRead(d *schema.ResourceData, meta interface{}) err
 
 		d.Set("domain_name", resp.Certificate.DomainName)
 		d.Set("arn", resp.Certificate.CertificateArn)
+		d.Set("certificate_authority_arn", resp.Certificate.CertificateAuthorityArn)
+		d.Set("renewal_eligibility", resp.Certificate.RenewalEligibility)
+
+		d.Set("not_after", "")
+		if resp.Certificate.NotAfter != nil {
+			d.Set("not_after", aws.TimeValue(resp.Certificate.NotAfter).Format(time.RFC3339))
+		}
+
+		d.Set("not_before", "")
+		if resp.Certificate.NotBefore != nil {
+			d.Set("not_before", aws.TimeValue(resp.Certificate.NotBefore).Format(time.RFC3339))
+		}
+
+		pendingRenewal, err := resourceAwsAcmCertificatePendingRenewal(d.Get("not_after").(string), d.Get("early_renewal_duration").(string))
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		d.Set("pending_renewal", pendingRenewal)
 
 		if err := d.Set("subject_alternative_names", cleanUpSubjectAlternativeNames(resp.Certificate)); err != nil {
 			return resource.NonRetryableError(err)
@@ -266,6 +333,22 @@ func resourceAwsAcmCertificateUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	// not_after is only marked as changing by the CustomizeDiff when the certificate is due for early renewal
+	if d.HasChange("not_after") {
+		oldNotAfter, _ := d.GetChange("not_after")
+
+		pendingRenewal, err := resourceAwsAcmCertificatePendingRenewal(oldNotAfter.(string), d.Get("early_renewal_duration").(string))
+		if err != nil {
+			return err
+		}
+
+		if pendingRenewal {
+			if err := resourceAwsAcmCertificateRenew(acmconn, d.Id(), oldNotAfter.(string)); err != nil {
+				return err
+			}
+		}
+	}
+
 	if d.HasChange("tags") {
 		err := setTagsACM(acmconn, d)
 		if err != nil {
@@ -275,6 +358,104 @@ func resourceAwsAcmCertificateUpdate(d *schema.ResourceData, meta interface{}) e
 	return resourceAwsAcmCertificateRead(d, meta)
 }
 
+func resourceAwsAcmCertificateCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	pendingRenewal, err := resourceAwsAcmCertificatePendingRenewal(diff.Get("not_after").(string), diff.Get("early_renewal_duration").(string))
+	if err != nil {
+		return err
+	}
+
+	if !pendingRenewal {
+		return nil
+	}
+
+	// Plan the renewal, which replaces the validity period of the certificate
+	for _, k := range []string{"not_after", "not_before", "pending_renewal"} {
+		if err := diff.SetNewComputed(k); err != nil {
+			return fmt.Errorf("error setting %s to computed: %s", k, err)
+		}
+	}
+
+	return nil
+}
+
+// resourceAwsAcmCertificatePendingRenewal returns whether a certificate expiring at notAfter (RFC3339)
+// is within earlyRenewalDuration of its expiry. Either being empty means no renewal is pending.
+func resourceAwsAcmCertificatePendingRenewal(notAfter, earlyRenewalDuration string) (bool, error) {
+	if notAfter == "" || earlyRenewalDuration == "" {
+		return false, nil
+	}
+
+	expiry, err := time.Parse(time.RFC3339, notAfter)
+	if err != nil {
+		return false, fmt.Errorf("error parsing certificate not_after (%s): %s", notAfter, err)
+	}
+
+	duration, err := time.ParseDuration(earlyRenewalDuration)
+	if err != nil {
+		return false, fmt.Errorf("error parsing early_renewal_duration (%s): %s", earlyRenewalDuration, err)
+	}
+
+	return time.Now().Add(duration).After(expiry), nil
+}
+
+func resourceAwsAcmCertificateRenew(conn *acm.ACM, arn, oldNotAfter string) error {
+	log.Printf("[INFO] Renewing ACM Certificate: %s", arn)
+	_, err := conn.RenewCertificate(&acm.RenewCertificateInput{
+		CertificateArn: aws.String(arn),
+	})
+	if err != nil {
+		return fmt.Errorf("error renewing ACM Certificate (%s): %s", arn, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"renewed"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := conn.DescribeCertificate(&acm.DescribeCertificateInput{
+				CertificateArn: aws.String(arn),
+			})
+			if err != nil {
+				return nil, "", err
+			}
+
+			certificate := resp.Certificate
+			if certificate == nil || certificate.NotAfter == nil {
+				return nil, "", fmt.Errorf("ACM Certificate (%s) not found", arn)
+			}
+
+			if certificate.RenewalSummary != nil && aws.StringValue(certificate.RenewalSummary.RenewalStatus) == acm.RenewalStatusFailed {
+				return nil, "", fmt.Errorf("renewal failed: %s", aws.StringValue(certificate.RenewalSummary.RenewalStatusReason))
+			}
+
+			if aws.TimeValue(certificate.NotAfter).Format(time.RFC3339) == oldNotAfter {
+				return certificate, "pending", nil
+			}
+
+			return certificate, "renewed", nil
+		},
+		Timeout: 5 * time.Minute,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for ACM Certificate (%s) renewal: %s", arn, err)
+	}
+
+	return nil
+}
+
+func acmDomainValidationOptionsHash(v interface{}) int {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return 0
+	}
+
+	return hashcode.String(m["domain_name"].(string))
+}
+
 func cleanUpSubjectAlternativeNames(cert *acm.CertificateDetail) []string {
 	sans := cert.SubjectAlternativeNames
 	vs := make([]string, 0)
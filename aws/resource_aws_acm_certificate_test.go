@@ -5,6 +5,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"os"
 	"regexp"
@@ -83,10 +84,7 @@ func TestAccAWSAcmCertificate_dnsValidation(t *testing.T) {
 					resource.TestMatchResourceAttr("aws_acm_certificate.cert", "arn", certificateArnRegex),
 					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_name", domain),
 					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_validation_options.#", "1"),
-					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_validation_options.0.domain_name", domain),
-					resource.TestCheckResourceAttrSet("aws_acm_certificate.cert", "domain_validation_options.0.resource_record_name"),
-					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_validation_options.0.resource_record_type", "CNAME"),
-					resource.TestCheckResourceAttrSet("aws_acm_certificate.cert", "domain_validation_options.0.resource_record_value"),
+					testAccCheckAcmCertificateDomainValidationOption("aws_acm_certificate.cert", domain),
 					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "subject_alternative_names.#", "0"),
 					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "validation_emails.#", "0"),
 					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "validation_method", acm.ValidationMethodDns),
@@ -115,10 +113,7 @@ func TestAccAWSAcmCertificate_root(t *testing.T) {
 					resource.TestMatchResourceAttr("aws_acm_certificate.cert", "arn", certificateArnRegex),
 					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_name", rootDomain),
 					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_validation_options.#", "1"),
-					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_validation_options.0.domain_name", rootDomain),
-					resource.TestCheckResourceAttrSet("aws_acm_certificate.cert", "domain_validation_options.0.resource_record_name"),
-					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_validation_options.0.resource_record_type", "CNAME"),
-					resource.TestCheckResourceAttrSet("aws_acm_certificate.cert", "domain_validation_options.0.resource_record_value"),
+					testAccCheckAcmCertificateDomainValidationOption("aws_acm_certificate.cert", rootDomain),
 					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "subject_alternative_names.#", "0"),
 					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "validation_emails.#", "0"),
 					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "validation_method", acm.ValidationMethodDns),
@@ -149,10 +144,7 @@ func TestAccAWSAcmCertificate_root_TrailingPeriod(t *testing.T) {
 					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "acm", regexp.MustCompile(`certificate/.+`)),
 					resource.TestCheckResourceAttr(resourceName, "domain_name", strings.TrimSuffix(domain, ".")),
 					resource.TestCheckResourceAttr(resourceName, "domain_validation_options.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "domain_validation_options.0.domain_name", strings.TrimSuffix(domain, ".")),
-					resource.TestCheckResourceAttrSet(resourceName, "domain_validation_options.0.resource_record_name"),
-					resource.TestCheckResourceAttr(resourceName, "domain_validation_options.0.resource_record_type", "CNAME"),
-					resource.TestCheckResourceAttrSet(resourceName, "domain_validation_options.0.resource_record_value"),
+					testAccCheckAcmCertificateDomainValidationOption(resourceName, strings.TrimSuffix(domain, ".")),
 					resource.TestCheckResourceAttr(resourceName, "subject_alternative_names.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "validation_emails.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "validation_method", acm.ValidationMethodDns),
@@ -182,14 +174,8 @@ func TestAccAWSAcmCertificate_rootAndWildcardSan(t *testing.T) {
 					resource.TestMatchResourceAttr("aws_acm_certificate.cert", "arn", certificateArnRegex),
 					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_name", rootDomain),
 					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_validation_options.#", "2"),
-					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_validation_options.0.domain_name", rootDomain),
-					resource.TestCheckResourceAttrSet("aws_acm_certificate.cert", "domain_validation_options.0.resource_record_name"),
-					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_validation_options.0.resource_record_type", "CNAME"),
-					resource.TestCheckResourceAttrSet("aws_acm_certificate.cert", "domain_validation_options.0.resource_record_value"),
-					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_validation_options.1.domain_name", wildcardDomain),
-					resource.TestCheckResourceAttrSet("aws_acm_certificate.cert", "domain_validation_options.1.resource_record_name"),
-					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_validation_options.1.resource_record_type", "CNAME"),
-					resource.TestCheckResourceAttrSet("aws_acm_certificate.cert", "domain_validation_options.1.resource_record_value"),
+					testAccCheckAcmCertificateDomainValidationOption("aws_acm_certificate.cert", rootDomain),
+					testAccCheckAcmCertificateDomainValidationOption("aws_acm_certificate.cert", wildcardDomain),
 					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "subject_alternative_names.#", "1"),
 					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "subject_alternative_names.0", wildcardDomain),
 					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "validation_emails.#", "0"),
@@ -224,14 +210,8 @@ func TestAccAWSAcmCertificate_san_single(t *testing.T) {
 					resource.TestMatchResourceAttr("aws_acm_certificate.cert", "arn", certificateArnRegex),
 					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_name", domain),
 					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_validation_options.#", "2"),
-					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_validation_options.0.domain_name", domain),
-					resource.TestCheckResourceAttrSet("aws_acm_certificate.cert", "domain_validation_options.0.resource_record_name"),
-					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_validation_options.0.resource_record_type", "CNAME"),
-					resource.TestCheckResourceAttrSet("aws_acm_certificate.cert", "domain_validation_options.0.resource_record_value"),
-					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_validation_options.1.domain_name", sanDomain),
-					resource.TestCheckResourceAttrSet("aws_acm_certificate.cert", "domain_validation_options.1.resource_record_name"),
-					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_validation_options.1.resource_record_type", "CNAME"),
-					resource.TestCheckResourceAttrSet("aws_acm_certificate.cert", "domain_validation_options.1.resource_record_value"),
+					testAccCheckAcmCertificateDomainValidationOption("aws_acm_certificate.cert", domain),
+					testAccCheckAcmCertificateDomainValidationOption("aws_acm_certificate.cert", sanDomain),
 					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "subject_alternative_names.#", "1"),
 					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "subject_alternative_names.0", sanDomain),
 					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "validation_emails.#", "0"),
@@ -267,18 +247,9 @@ func TestAccAWSAcmCertificate_san_multiple(t *testing.T) {
 					resource.TestMatchResourceAttr("aws_acm_certificate.cert", "arn", certificateArnRegex),
 					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_name", domain),
 					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_validation_options.#", "3"),
-					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_validation_options.0.domain_name", domain),
-					resource.TestCheckResourceAttrSet("aws_acm_certificate.cert", "domain_validation_options.0.resource_record_name"),
-					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_validation_options.0.resource_record_type", "CNAME"),
-					resource.TestCheckResourceAttrSet("aws_acm_certificate.cert", "domain_validation_options.0.resource_record_value"),
-					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_validation_options.1.domain_name", sanDomain1),
-					resource.TestCheckResourceAttrSet("aws_acm_certificate.cert", "domain_validation_options.1.resource_record_name"),
-					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_validation_options.1.resource_record_type", "CNAME"),
-					resource.TestCheckResourceAttrSet("aws_acm_certificate.cert", "domain_validation_options.1.resource_record_value"),
-					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_validation_options.2.domain_name", sanDomain2),
-					resource.TestCheckResourceAttrSet("aws_acm_certificate.cert", "domain_validation_options.2.resource_record_name"),
-					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_validation_options.2.resource_record_type", "CNAME"),
-					resource.TestCheckResourceAttrSet("aws_acm_certificate.cert", "domain_validation_options.2.resource_record_value"),
+					testAccCheckAcmCertificateDomainValidationOption("aws_acm_certificate.cert", domain),
+					testAccCheckAcmCertificateDomainValidationOption("aws_acm_certificate.cert", sanDomain1),
+					testAccCheckAcmCertificateDomainValidationOption("aws_acm_certificate.cert", sanDomain2),
 					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "subject_alternative_names.#", "2"),
 					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "subject_alternative_names.0", sanDomain1),
 					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "subject_alternative_names.1", sanDomain2),
@@ -315,14 +286,8 @@ func TestAccAWSAcmCertificate_san_TrailingPeriod(t *testing.T) {
 					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "acm", regexp.MustCompile(`certificate/.+`)),
 					resource.TestCheckResourceAttr(resourceName, "domain_name", domain),
 					resource.TestCheckResourceAttr(resourceName, "domain_validation_options.#", "2"),
-					resource.TestCheckResourceAttr(resourceName, "domain_validation_options.0.domain_name", domain),
-					resource.TestCheckResourceAttrSet(resourceName, "domain_validation_options.0.resource_record_name"),
-					resource.TestCheckResourceAttr(resourceName, "domain_validation_options.0.resource_record_type", "CNAME"),
-					resource.TestCheckResourceAttrSet(resourceName, "domain_validation_options.0.resource_record_value"),
-					resource.TestCheckResourceAttr(resourceName, "domain_validation_options.1.domain_name", strings.TrimSuffix(sanDomain, ".")),
-					resource.TestCheckResourceAttrSet(resourceName, "domain_validation_options.1.resource_record_name"),
-					resource.TestCheckResourceAttr(resourceName, "domain_validation_options.1.resource_record_type", "CNAME"),
-					resource.TestCheckResourceAttrSet(resourceName, "domain_validation_options.1.resource_record_value"),
+					testAccCheckAcmCertificateDomainValidationOption(resourceName, domain),
+					testAccCheckAcmCertificateDomainValidationOption(resourceName, strings.TrimSuffix(sanDomain, ".")),
 					resource.TestCheckResourceAttr(resourceName, "subject_alternative_names.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "subject_alternative_names.0", strings.TrimSuffix(sanDomain, ".")),
 					resource.TestCheckResourceAttr(resourceName, "validation_emails.#", "0"),
@@ -353,10 +318,7 @@ func TestAccAWSAcmCertificate_wildcard(t *testing.T) {
 					resource.TestMatchResourceAttr("aws_acm_certificate.cert", "arn", certificateArnRegex),
 					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_name", wildcardDomain),
 					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_validation_options.#", "1"),
-					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_validation_options.0.domain_name", wildcardDomain),
-					resource.TestCheckResourceAttrSet("aws_acm_certificate.cert", "domain_validation_options.0.resource_record_name"),
-					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_validation_options.0.resource_record_type", "CNAME"),
-					resource.TestCheckResourceAttrSet("aws_acm_certificate.cert", "domain_validation_options.0.resource_record_value"),
+					testAccCheckAcmCertificateDomainValidationOption("aws_acm_certificate.cert", wildcardDomain),
 					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "subject_alternative_names.#", "0"),
 					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "validation_emails.#", "0"),
 					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "validation_method", acm.ValidationMethodDns),
@@ -386,14 +348,8 @@ func TestAccAWSAcmCertificate_wildcardAndRootSan(t *testing.T) {
 					resource.TestMatchResourceAttr("aws_acm_certificate.cert", "arn", certificateArnRegex),
 					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_name", wildcardDomain),
 					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_validation_options.#", "2"),
-					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_validation_options.0.domain_name", wildcardDomain),
-					resource.TestCheckResourceAttrSet("aws_acm_certificate.cert", "domain_validation_options.0.resource_record_name"),
-					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_validation_options.0.resource_record_type", "CNAME"),
-					resource.TestCheckResourceAttrSet("aws_acm_certificate.cert", "domain_validation_options.0.resource_record_value"),
-					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_validation_options.1.domain_name", rootDomain),
-					resource.TestCheckResourceAttrSet("aws_acm_certificate.cert", "domain_validation_options.1.resource_record_name"),
-					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_validation_options.1.resource_record_type", "CNAME"),
-					resource.TestCheckResourceAttrSet("aws_acm_certificate.cert", "domain_validation_options.1.resource_record_value"),
+					testAccCheckAcmCertificateDomainValidationOption("aws_acm_certificate.cert", wildcardDomain),
+					testAccCheckAcmCertificateDomainValidationOption("aws_acm_certificate.cert", rootDomain),
 					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "subject_alternative_names.#", "1"),
 					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "subject_alternative_names.0", rootDomain),
 					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "validation_emails.#", "0"),
@@ -519,6 +475,104 @@ func TestAccAWSAcmCertificate_imported_IpAddress(t *testing.T) {
 	})
 }
 
+func TestAccAWSAcmCertificate_privateCertificate(t *testing.T) {
+	resourceName := "aws_acm_certificate.cert"
+	domain := fmt.Sprintf("tf-acc-%d.terraformtesting.com", acctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAcmCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAcmCertificateConfig_privateCertificate(domain, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "arn", certificateArnRegex),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_authority_arn", "aws_acmpca_certificate_authority.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "domain_name", domain),
+					resource.TestCheckResourceAttr(resourceName, "domain_validation_options.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "validation_emails.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "not_after"),
+					resource.TestCheckResourceAttrSet(resourceName, "not_before"),
+					resource.TestCheckResourceAttr(resourceName, "pending_renewal", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// The early renewal window exceeds the certificate's validity, so every refresh plans a renewal
+				Config:             testAccAcmCertificateConfig_privateCertificate(domain, "87600h"),
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "early_renewal_duration", "87600h"),
+					resource.TestCheckResourceAttr(resourceName, "pending_renewal", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceAwsAcmCertificatePendingRenewal(t *testing.T) {
+	testCases := []struct {
+		NotAfter             string
+		EarlyRenewalDuration string
+		Expected             bool
+		ErrorExpected        bool
+	}{
+		{
+			NotAfter:             "",
+			EarlyRenewalDuration: "720h",
+			Expected:             false,
+		},
+		{
+			NotAfter:             time.Now().Add(1000 * time.Hour).Format(time.RFC3339),
+			EarlyRenewalDuration: "",
+			Expected:             false,
+		},
+		{
+			NotAfter:             time.Now().Add(1000 * time.Hour).Format(time.RFC3339),
+			EarlyRenewalDuration: "720h",
+			Expected:             false,
+		},
+		{
+			NotAfter:             time.Now().Add(500 * time.Hour).Format(time.RFC3339),
+			EarlyRenewalDuration: "720h",
+			Expected:             true,
+		},
+		{
+			NotAfter:             "not-a-timestamp",
+			EarlyRenewalDuration: "720h",
+			ErrorExpected:        true,
+		},
+		{
+			NotAfter:             time.Now().Format(time.RFC3339),
+			EarlyRenewalDuration: "30 days",
+			ErrorExpected:        true,
+		},
+	}
+
+	for i, tc := range testCases {
+		got, err := resourceAwsAcmCertificatePendingRenewal(tc.NotAfter, tc.EarlyRenewalDuration)
+
+		if tc.ErrorExpected && err == nil {
+			t.Errorf("case %d: expected error, got none", i)
+			continue
+		}
+
+		if !tc.ErrorExpected && err != nil {
+			t.Errorf("case %d: unexpected error: %s", i, err)
+			continue
+		}
+
+		if got != tc.Expected {
+			t.Errorf("case %d: expected %t, got %t", i, tc.Expected, got)
+		}
+	}
+}
+
 func testAccAcmCertificateConfig(domainName, validationMethod string) string {
 	return fmt.Sprintf(`
 resource "aws_acm_certificate" "cert" {
@@ -627,6 +681,39 @@ resource "aws_acm_certificate" "test" {
 `, commonName)
 }
 
+// testAccCheckAcmCertificateDomainValidationOption verifies that the domain_validation_options set
+// contains a DNS validation record for the given domain name.
+func testAccCheckAcmCertificateDomainValidationOption(resourceName, domainName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		for k, v := range rs.Primary.Attributes {
+			if !strings.HasPrefix(k, "domain_validation_options.") || !strings.HasSuffix(k, ".domain_name") || v != domainName {
+				continue
+			}
+
+			prefix := strings.TrimSuffix(k, "domain_name")
+
+			if rs.Primary.Attributes[prefix+"resource_record_name"] == "" {
+				return fmt.Errorf("%s: domain validation option for %s has no resource_record_name", resourceName, domainName)
+			}
+			if got := rs.Primary.Attributes[prefix+"resource_record_type"]; got != "CNAME" {
+				return fmt.Errorf("%s: domain validation option for %s has resource_record_type %q, expected CNAME", resourceName, domainName, got)
+			}
+			if rs.Primary.Attributes[prefix+"resource_record_value"] == "" {
+				return fmt.Errorf("%s: domain validation option for %s has no resource_record_value", resourceName, domainName)
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("%s: no domain validation option found for %s", resourceName, domainName)
+	}
+}
+
 func testAccCheckAcmCertificateDestroy(s *terraform.State) error {
 	acmconn := testAccProvider.Meta().(*AWSClient).acmconn
 
@@ -650,3 +737,18 @@ func testAccCheckAcmCertificateDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func testAccAcmCertificateConfig_privateCertificate(domainName, earlyRenewalDuration string) string {
+	earlyRenewal := ""
+	if earlyRenewalDuration != "" {
+		earlyRenewal = fmt.Sprintf("early_renewal_duration    = %q", earlyRenewalDuration)
+	}
+
+	return testAccAwsAcmpcaCertificateAuthorityCertificateConfig_Basic + fmt.Sprintf(`
+resource "aws_acm_certificate" "cert" {
+  domain_name               = "%s"
+  certificate_authority_arn = "${aws_acmpca_certificate_authority_certificate.test.certificate_authority_arn}"
+  %s
+}
+`, domainName, earlyRenewal)
+}
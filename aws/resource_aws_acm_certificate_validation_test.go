@@ -195,10 +195,10 @@ data "aws_route53_zone" "zone" {
 }
 
 resource "aws_route53_record" "cert_validation" {
-  name = "${aws_acm_certificate.cert.domain_validation_options.0.resource_record_name}"
-  type = "${aws_acm_certificate.cert.domain_validation_options.0.resource_record_type}"
+  name = "${tolist(aws_acm_certificate.cert.domain_validation_options)[0].resource_record_name}"
+  type = "${tolist(aws_acm_certificate.cert.domain_validation_options)[0].resource_record_type}"
   zone_id = "${data.aws_route53_zone.zone.id}"
-  records = ["${aws_acm_certificate.cert.domain_validation_options.0.resource_record_value}"]
+  records = ["${tolist(aws_acm_certificate.cert.domain_validation_options)[0].resource_record_value}"]
   ttl = 60
 }
 
@@ -244,10 +244,10 @@ data "aws_route53_zone" "zone" {
 }
 
 resource "aws_route53_record" "cert_validation" {
-  name = "${aws_acm_certificate.cert.domain_validation_options.0.resource_record_name}"
-  type = "${aws_acm_certificate.cert.domain_validation_options.0.resource_record_type}"
+  name = "${tolist(aws_acm_certificate.cert.domain_validation_options)[0].resource_record_name}"
+  type = "${tolist(aws_acm_certificate.cert.domain_validation_options)[0].resource_record_type}"
   zone_id = "${data.aws_route53_zone.zone.id}"
-  records = ["${aws_acm_certificate.cert.domain_validation_options.0.resource_record_value}"]
+  records = ["${tolist(aws_acm_certificate.cert.domain_validation_options)[0].resource_record_value}"]
   ttl = 60
 }
 
@@ -268,18 +268,18 @@ data "aws_route53_zone" "zone" {
 }
 
 resource "aws_route53_record" "cert_validation" {
-  name = "${aws_acm_certificate.cert.domain_validation_options.0.resource_record_name}"
-  type = "${aws_acm_certificate.cert.domain_validation_options.0.resource_record_type}"
+  name = "${tolist(aws_acm_certificate.cert.domain_validation_options)[0].resource_record_name}"
+  type = "${tolist(aws_acm_certificate.cert.domain_validation_options)[0].resource_record_type}"
   zone_id = "${data.aws_route53_zone.zone.id}"
-  records = ["${aws_acm_certificate.cert.domain_validation_options.0.resource_record_value}"]
+  records = ["${tolist(aws_acm_certificate.cert.domain_validation_options)[0].resource_record_value}"]
   ttl = 60
 }
 
 resource "aws_route53_record" "cert_validation_san" {
-  name = "${aws_acm_certificate.cert.domain_validation_options.1.resource_record_name}"
-  type = "${aws_acm_certificate.cert.domain_validation_options.1.resource_record_type}"
+  name = "${tolist(aws_acm_certificate.cert.domain_validation_options)[1].resource_record_name}"
+  type = "${tolist(aws_acm_certificate.cert.domain_validation_options)[1].resource_record_type}"
   zone_id = "${data.aws_route53_zone.zone.id}"
-  records = ["${aws_acm_certificate.cert.domain_validation_options.1.resource_record_value}"]
+  records = ["${tolist(aws_acm_certificate.cert.domain_validation_options)[1].resource_record_value}"]
   ttl = 60
 }
 
@@ -0,0 +1,228 @@
+package aws
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsAcmpcaCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAcmpcaCertificateCreate,
+		Read:   resourceAwsAcmpcaCertificateRead,
+		Delete: resourceAwsAcmpcaCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		// Issued certificates are immutable, so every argument forces a new resource.
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate_authority_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"certificate_chain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate_signing_request": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: normalizeCert,
+			},
+			"signing_algorithm": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					acmpca.SigningAlgorithmSha256withecdsa,
+					acmpca.SigningAlgorithmSha256withrsa,
+					acmpca.SigningAlgorithmSha384withecdsa,
+					acmpca.SigningAlgorithmSha384withrsa,
+					acmpca.SigningAlgorithmSha512withecdsa,
+					acmpca.SigningAlgorithmSha512withrsa,
+				}, false),
+			},
+			// https://docs.aws.amazon.com/acm-pca/latest/APIReference/API_Validity.html
+			"validity": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								acmpca.ValidityPeriodTypeAbsolute,
+								acmpca.ValidityPeriodTypeDays,
+								acmpca.ValidityPeriodTypeEndDate,
+								acmpca.ValidityPeriodTypeMonths,
+								acmpca.ValidityPeriodTypeYears,
+							}, false),
+						},
+						"value": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsAcmpcaCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmpcaconn
+
+	certificateAuthorityArn := d.Get("certificate_authority_arn").(string)
+	input := &acmpca.IssueCertificateInput{
+		CertificateAuthorityArn: aws.String(certificateAuthorityArn),
+		Csr:                     []byte(d.Get("certificate_signing_request").(string)),
+		IdempotencyToken:        aws.String(resource.UniqueId()),
+		SigningAlgorithm:        aws.String(d.Get("signing_algorithm").(string)),
+		Validity:                expandAcmpcaValidity(d.Get("validity").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Issuing ACMPCA Certificate: %s", input)
+	output, err := conn.IssueCertificate(input)
+	if err != nil {
+		return fmt.Errorf("error issuing ACMPCA Certificate with Certificate Authority (%s): %s", certificateAuthorityArn, err)
+	}
+
+	d.SetId(aws.StringValue(output.CertificateArn))
+
+	log.Printf("[DEBUG] Waiting for ACMPCA Certificate (%s) to be issued", d.Id())
+	err = conn.WaitUntilCertificateIssued(&acmpca.GetCertificateInput{
+		CertificateArn:          aws.String(d.Id()),
+		CertificateAuthorityArn: aws.String(certificateAuthorityArn),
+	})
+	if err != nil {
+		return fmt.Errorf("error waiting for ACMPCA Certificate (%s) to be issued: %s", d.Id(), err)
+	}
+
+	return resourceAwsAcmpcaCertificateRead(d, meta)
+}
+
+func resourceAwsAcmpcaCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmpcaconn
+
+	certificateAuthorityArn, err := acmpcaCertificateAuthorityArnFromCertificateArn(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &acmpca.GetCertificateInput{
+		CertificateArn:          aws.String(d.Id()),
+		CertificateAuthorityArn: aws.String(certificateAuthorityArn),
+	}
+
+	log.Printf("[DEBUG] Reading ACMPCA Certificate: %s", input)
+	output, err := conn.GetCertificate(input)
+
+	if isAWSErr(err, acmpca.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] ACMPCA Certificate (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading ACMPCA Certificate (%s): %s", d.Id(), err)
+	}
+
+	if output == nil {
+		log.Printf("[WARN] ACMPCA Certificate (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", d.Id())
+	d.Set("certificate_authority_arn", certificateAuthorityArn)
+	d.Set("certificate", output.Certificate)
+	d.Set("certificate_chain", output.CertificateChain)
+
+	return nil
+}
+
+func resourceAwsAcmpcaCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmpcaconn
+
+	block, _ := pem.Decode([]byte(d.Get("certificate").(string)))
+	if block == nil {
+		log.Printf("[WARN] Failed to parse ACMPCA Certificate (%s), removing from state without revoking", d.Id())
+		return nil
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return fmt.Errorf("error parsing ACMPCA Certificate (%s): %s", d.Id(), err)
+	}
+
+	input := &acmpca.RevokeCertificateInput{
+		CertificateAuthorityArn: aws.String(d.Get("certificate_authority_arn").(string)),
+		CertificateSerial:       aws.String(fmt.Sprintf("%x", cert.SerialNumber)),
+		RevocationReason:        aws.String(acmpca.RevocationReasonUnspecified),
+	}
+
+	log.Printf("[DEBUG] Revoking ACMPCA Certificate: %s", input)
+	_, err = conn.RevokeCertificate(input)
+
+	if isAWSErr(err, acmpca.ErrCodeResourceNotFoundException, "") ||
+		isAWSErr(err, acmpca.ErrCodeRequestAlreadyProcessedException, "") ||
+		isAWSErr(err, acmpca.ErrCodeRequestInProgressException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error revoking ACMPCA Certificate (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// acmpcaCertificateAuthorityArnFromCertificateArn returns the ARN of the issuing certificate authority,
+// as certificate ARNs are of the form arn:PARTITION:acm-pca:REGION:ACCOUNT:certificate-authority/CA_ID/certificate/SERIAL.
+func acmpcaCertificateAuthorityArnFromCertificateArn(certificateArn string) (string, error) {
+	parts := strings.Split(certificateArn, "/certificate/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", fmt.Errorf("expected ACMPCA Certificate ARN in format arn:PARTITION:acm-pca:REGION:ACCOUNT:certificate-authority/CA_ID/certificate/SERIAL, received: %s", certificateArn)
+	}
+
+	return parts[0], nil
+}
+
+func expandAcmpcaValidity(l []interface{}) *acmpca.Validity {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &acmpca.Validity{
+		Type:  aws.String(m["type"].(string)),
+		Value: aws.Int64(int64(m["value"].(int))),
+	}
+}
@@ -497,6 +497,31 @@ func resourceAwsAcmpcaCertificateAuthorityUpdate(d *schema.ResourceData, meta in
 func resourceAwsAcmpcaCertificateAuthorityDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).acmpcaconn
 
+	// Certificate authorities activated with a certificate must be disabled before they can be deleted.
+	// The status in state may predate the activation, so check it directly.
+	describeCertificateAuthorityOutput, err := conn.DescribeCertificateAuthority(&acmpca.DescribeCertificateAuthorityInput{
+		CertificateAuthorityArn: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, acmpca.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("error reading ACMPCA Certificate Authority: %s", err)
+	}
+
+	if describeCertificateAuthorityOutput.CertificateAuthority != nil && aws.StringValue(describeCertificateAuthorityOutput.CertificateAuthority.Status) == acmpca.CertificateAuthorityStatusActive {
+		updateInput := &acmpca.UpdateCertificateAuthorityInput{
+			CertificateAuthorityArn: aws.String(d.Id()),
+			Status:                  aws.String(acmpca.CertificateAuthorityStatusDisabled),
+		}
+
+		log.Printf("[DEBUG] Disabling ACMPCA Certificate Authority: %s", updateInput)
+		_, err := conn.UpdateCertificateAuthority(updateInput)
+		if err != nil {
+			return fmt.Errorf("error disabling ACMPCA Certificate Authority: %s", err)
+		}
+	}
+
 	input := &acmpca.DeleteCertificateAuthorityInput{
 		CertificateAuthorityArn: aws.String(d.Id()),
 	}
@@ -506,7 +531,7 @@ func resourceAwsAcmpcaCertificateAuthorityDelete(d *schema.ResourceData, meta in
 	}
 
 	log.Printf("[DEBUG] Deleting ACMPCA Certificate Authority: %s", input)
-	_, err := conn.DeleteCertificateAuthority(input)
+	_, err = conn.DeleteCertificateAuthority(input)
 	if err != nil {
 		if isAWSErr(err, acmpca.ErrCodeResourceNotFoundException, "") {
 			return nil
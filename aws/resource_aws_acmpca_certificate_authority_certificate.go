@@ -0,0 +1,108 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsAcmpcaCertificateAuthorityCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAcmpcaCertificateAuthorityCertificateCreate,
+		Read:   resourceAwsAcmpcaCertificateAuthorityCertificateRead,
+		Delete: resourceAwsAcmpcaCertificateAuthorityCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"certificate": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: normalizeCert,
+			},
+			"certificate_authority_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"certificate_chain": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: normalizeCert,
+			},
+		},
+	}
+}
+
+func resourceAwsAcmpcaCertificateAuthorityCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmpcaconn
+
+	certificateAuthorityArn := d.Get("certificate_authority_arn").(string)
+	input := &acmpca.ImportCertificateAuthorityCertificateInput{
+		Certificate:             []byte(d.Get("certificate").(string)),
+		CertificateAuthorityArn: aws.String(certificateAuthorityArn),
+		CertificateChain:        []byte(d.Get("certificate_chain").(string)),
+	}
+
+	log.Printf("[DEBUG] Importing ACMPCA Certificate Authority Certificate: %s", certificateAuthorityArn)
+	_, err := conn.ImportCertificateAuthorityCertificate(input)
+	if err != nil {
+		return fmt.Errorf("error importing ACMPCA Certificate Authority (%s) Certificate: %s", certificateAuthorityArn, err)
+	}
+
+	d.SetId(certificateAuthorityArn)
+
+	return resourceAwsAcmpcaCertificateAuthorityCertificateRead(d, meta)
+}
+
+func resourceAwsAcmpcaCertificateAuthorityCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmpcaconn
+
+	input := &acmpca.GetCertificateAuthorityCertificateInput{
+		CertificateAuthorityArn: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Reading ACMPCA Certificate Authority Certificate: %s", input)
+	output, err := conn.GetCertificateAuthorityCertificate(input)
+
+	if isAWSErr(err, acmpca.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] ACMPCA Certificate Authority (%s) not found, removing Certificate from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// Returned when the certificate authority is in PENDING_CERTIFICATE or DELETED status
+	if isAWSErr(err, acmpca.ErrCodeInvalidStateException, "") {
+		log.Printf("[WARN] ACMPCA Certificate Authority (%s) has no Certificate, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading ACMPCA Certificate Authority (%s) Certificate: %s", d.Id(), err)
+	}
+
+	if output == nil || aws.StringValue(output.Certificate) == "" {
+		log.Printf("[WARN] ACMPCA Certificate Authority (%s) has no Certificate, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("certificate_authority_arn", d.Id())
+	d.Set("certificate", normalizeCert(output.Certificate))
+	d.Set("certificate_chain", normalizeCert(output.CertificateChain))
+
+	return nil
+}
+
+func resourceAwsAcmpcaCertificateAuthorityCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARN] Cannot remove the Certificate from ACMPCA Certificate Authority (%s). Terraform will remove this resource from the state file, however the Certificate Authority remains active.", d.Id())
+	return nil
+}
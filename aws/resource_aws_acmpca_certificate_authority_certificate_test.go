@@ -0,0 +1,132 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAwsAcmpcaCertificateAuthorityCertificate_Basic(t *testing.T) {
+	var certificateAuthority acmpca.CertificateAuthority
+	resourceName := "aws_acmpca_certificate_authority_certificate.test"
+	certificateAuthorityResourceName := "aws_acmpca_certificate_authority.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAcmpcaCertificateAuthorityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsAcmpcaCertificateAuthorityCertificateConfig_Basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAcmpcaCertificateAuthorityCertificateExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_authority_arn", certificateAuthorityResourceName, "arn"),
+					testAccCheckAwsAcmpcaCertificateAuthorityExists(certificateAuthorityResourceName, &certificateAuthority),
+					testAccCheckAwsAcmpcaCertificateAuthorityStatus(&certificateAuthority, acmpca.CertificateAuthorityStatusActive),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsAcmpcaCertificateAuthorityCertificateExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).acmpcaconn
+
+		output, err := conn.GetCertificateAuthorityCertificate(&acmpca.GetCertificateAuthorityCertificateInput{
+			CertificateAuthorityArn: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || aws.StringValue(output.Certificate) == "" {
+			return fmt.Errorf("ACMPCA Certificate Authority (%s) has no Certificate", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAwsAcmpcaCertificateAuthorityStatus(certificateAuthority *acmpca.CertificateAuthority, status string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := aws.StringValue(certificateAuthority.Status); got != status {
+			return fmt.Errorf("ACMPCA Certificate Authority (%s) status is %s, expected %s", aws.StringValue(certificateAuthority.Arn), got, status)
+		}
+
+		return nil
+	}
+}
+
+// testAccAwsAcmpcaCertificateAuthorityCertificateConfig_Basic activates a subordinate certificate authority
+// with a certificate signed by a locally generated root certificate authority.
+const testAccAwsAcmpcaCertificateAuthorityCertificateConfig_Basic = `
+resource "aws_acmpca_certificate_authority" "test" {
+  permanent_deletion_time_in_days = 7
+
+  certificate_authority_configuration {
+    key_algorithm     = "RSA_4096"
+    signing_algorithm = "SHA512WITHRSA"
+
+    subject {
+      common_name = "terraformtesting.com"
+    }
+  }
+}
+
+resource "tls_private_key" "root" {
+  algorithm = "RSA"
+}
+
+resource "tls_self_signed_cert" "root" {
+  key_algorithm         = "RSA"
+  private_key_pem       = "${tls_private_key.root.private_key_pem}"
+  is_ca_certificate     = true
+  validity_period_hours = 12
+
+  allowed_uses = [
+    "cert_signing",
+    "crl_signing",
+  ]
+
+  subject {
+    common_name  = "root.terraformtesting.com"
+    organization = "ACME Examples, Inc"
+  }
+}
+
+resource "tls_locally_signed_cert" "test" {
+  cert_request_pem      = "${aws_acmpca_certificate_authority.test.certificate_signing_request}"
+  ca_key_algorithm      = "RSA"
+  ca_private_key_pem    = "${tls_private_key.root.private_key_pem}"
+  ca_cert_pem           = "${tls_self_signed_cert.root.cert_pem}"
+  is_ca_certificate     = true
+  validity_period_hours = 12
+
+  allowed_uses = [
+    "cert_signing",
+    "crl_signing",
+  ]
+}
+
+resource "aws_acmpca_certificate_authority_certificate" "test" {
+  certificate_authority_arn = "${aws_acmpca_certificate_authority.test.arn}"
+  certificate               = "${tls_locally_signed_cert.test.cert_pem}"
+  certificate_chain         = "${tls_self_signed_cert.root.cert_pem}"
+}
+`
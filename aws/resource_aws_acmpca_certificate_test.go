@@ -0,0 +1,144 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAwsAcmpcaCertificate_Basic(t *testing.T) {
+	resourceName := "aws_acmpca_certificate.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAcmpcaCertificateAuthorityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsAcmpcaCertificateConfig_Basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAcmpcaCertificateExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:acm-pca:[^:]+:[^:]+:certificate-authority/.+/certificate/.+$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_authority_arn", "aws_acmpca_certificate_authority.test", "arn"),
+					resource.TestMatchResourceAttr(resourceName, "certificate", regexp.MustCompile(`^-----BEGIN CERTIFICATE-----`)),
+					resource.TestMatchResourceAttr(resourceName, "certificate_chain", regexp.MustCompile(`^-----BEGIN CERTIFICATE-----`)),
+					resource.TestCheckResourceAttr(resourceName, "signing_algorithm", "SHA256WITHRSA"),
+					resource.TestCheckResourceAttr(resourceName, "validity.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "validity.0.type", "DAYS"),
+					resource.TestCheckResourceAttr(resourceName, "validity.0.value", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"certificate_signing_request",
+					"signing_algorithm",
+					"validity",
+				},
+			},
+		},
+	})
+}
+
+func TestAcmpcaCertificateAuthorityArnFromCertificateArn(t *testing.T) {
+	testCases := []struct {
+		Input         string
+		Expected      string
+		ErrorExpected bool
+	}{
+		{
+			Input:    "arn:aws:acm-pca:us-west-2:123456789012:certificate-authority/12345678-1234-1234-1234-123456789012/certificate/286535153982981100925020015808220737245",
+			Expected: "arn:aws:acm-pca:us-west-2:123456789012:certificate-authority/12345678-1234-1234-1234-123456789012",
+		},
+		{
+			Input:         "arn:aws:acm-pca:us-west-2:123456789012:certificate-authority/12345678-1234-1234-1234-123456789012",
+			ErrorExpected: true,
+		},
+		{
+			Input:         "arn:aws:acm-pca:us-west-2:123456789012:certificate-authority/12345678-1234-1234-1234-123456789012/certificate/",
+			ErrorExpected: true,
+		},
+		{
+			Input:         "",
+			ErrorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		got, err := acmpcaCertificateAuthorityArnFromCertificateArn(tc.Input)
+
+		if tc.ErrorExpected && err == nil {
+			t.Errorf("expected error for %q, got none", tc.Input)
+			continue
+		}
+
+		if !tc.ErrorExpected && err != nil {
+			t.Errorf("unexpected error for %q: %s", tc.Input, err)
+			continue
+		}
+
+		if got != tc.Expected {
+			t.Errorf("input %q: expected %q, got %q", tc.Input, tc.Expected, got)
+		}
+	}
+}
+
+func testAccCheckAwsAcmpcaCertificateExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).acmpcaconn
+
+		output, err := conn.GetCertificate(&acmpca.GetCertificateInput{
+			CertificateArn:          aws.String(rs.Primary.ID),
+			CertificateAuthorityArn: aws.String(rs.Primary.Attributes["certificate_authority_arn"]),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || aws.StringValue(output.Certificate) == "" {
+			return fmt.Errorf("ACMPCA Certificate (%s) does not exist", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+var testAccAwsAcmpcaCertificateConfig_Basic = testAccAwsAcmpcaCertificateAuthorityCertificateConfig_Basic + `
+resource "tls_private_key" "test" {
+  algorithm = "RSA"
+}
+
+resource "tls_cert_request" "test" {
+  key_algorithm   = "RSA"
+  private_key_pem = "${tls_private_key.test.private_key_pem}"
+
+  subject {
+    common_name = "leaf.terraformtesting.com"
+  }
+}
+
+resource "aws_acmpca_certificate" "test" {
+  # The certificate authority can only issue certificates once it is activated.
+  certificate_authority_arn   = "${aws_acmpca_certificate_authority_certificate.test.certificate_authority_arn}"
+  certificate_signing_request = "${tls_cert_request.test.cert_request_pem}"
+  signing_algorithm           = "SHA256WITHRSA"
+
+  validity {
+    type  = "DAYS"
+    value = 1
+  }
+}
+`
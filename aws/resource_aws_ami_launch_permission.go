@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsAmiLaunchPermission() *schema.Resource {
@@ -25,9 +26,17 @@ func resourceAwsAmiLaunchPermission() *schema.Resource {
 				ForceNew: true,
 			},
 			"account_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"group"},
+			},
+			"group": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"account_id"},
+				ValidateFunc:  validation.StringInSlice([]string{ec2.PermissionGroupAll}, false),
 			},
 		},
 	}
@@ -38,7 +47,8 @@ func resourceAwsAmiLaunchPermissionExists(d *schema.ResourceData, meta interface
 
 	image_id := d.Get("image_id").(string)
 	account_id := d.Get("account_id").(string)
-	return hasLaunchPermission(conn, image_id, account_id)
+	group := d.Get("group").(string)
+	return hasLaunchPermission(conn, image_id, account_id, group)
 }
 
 func resourceAwsAmiLaunchPermissionCreate(d *schema.ResourceData, meta interface{}) error {
@@ -46,13 +56,18 @@ func resourceAwsAmiLaunchPermissionCreate(d *schema.ResourceData, meta interface
 
 	image_id := d.Get("image_id").(string)
 	account_id := d.Get("account_id").(string)
+	group := d.Get("group").(string)
+
+	if account_id == "" && group == "" {
+		return fmt.Errorf("one of account_id or group must be configured")
+	}
 
 	_, err := conn.ModifyImageAttribute(&ec2.ModifyImageAttributeInput{
 		ImageId:   aws.String(image_id),
 		Attribute: aws.String("launchPermission"),
 		LaunchPermission: &ec2.LaunchPermissionModifications{
 			Add: []*ec2.LaunchPermission{
-				{UserId: aws.String(account_id)},
+				expandEc2LaunchPermission(account_id, group),
 			},
 		},
 	})
@@ -60,7 +75,11 @@ func resourceAwsAmiLaunchPermissionCreate(d *schema.ResourceData, meta interface
 		return fmt.Errorf("error creating ami launch permission: %s", err)
 	}
 
-	d.SetId(fmt.Sprintf("%s-%s", image_id, account_id))
+	if group != "" {
+		d.SetId(fmt.Sprintf("%s-%s", image_id, group))
+	} else {
+		d.SetId(fmt.Sprintf("%s-%s", image_id, account_id))
+	}
 	return nil
 }
 
@@ -73,13 +92,14 @@ func resourceAwsAmiLaunchPermissionDelete(d *schema.ResourceData, meta interface
 
 	image_id := d.Get("image_id").(string)
 	account_id := d.Get("account_id").(string)
+	group := d.Get("group").(string)
 
 	_, err := conn.ModifyImageAttribute(&ec2.ModifyImageAttributeInput{
 		ImageId:   aws.String(image_id),
 		Attribute: aws.String("launchPermission"),
 		LaunchPermission: &ec2.LaunchPermissionModifications{
 			Remove: []*ec2.LaunchPermission{
-				{UserId: aws.String(account_id)},
+				expandEc2LaunchPermission(account_id, group),
 			},
 		},
 	})
@@ -90,7 +110,7 @@ func resourceAwsAmiLaunchPermissionDelete(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func hasLaunchPermission(conn *ec2.EC2, image_id string, account_id string) (bool, error) {
+func hasLaunchPermission(conn *ec2.EC2, image_id string, account_id string, group string) (bool, error) {
 	attrs, err := conn.DescribeImageAttribute(&ec2.DescribeImageAttributeInput{
 		ImageId:   aws.String(image_id),
 		Attribute: aws.String("launchPermission"),
@@ -106,9 +126,20 @@ func hasLaunchPermission(conn *ec2.EC2, image_id string, account_id string) (boo
 	}
 
 	for _, lp := range attrs.LaunchPermissions {
-		if aws.StringValue(lp.UserId) == account_id {
+		if group != "" && aws.StringValue(lp.Group) == group {
+			return true, nil
+		}
+		if account_id != "" && aws.StringValue(lp.UserId) == account_id {
 			return true, nil
 		}
 	}
 	return false, nil
 }
+
+// expandEc2LaunchPermission returns a launch permission for either an account or a group.
+func expandEc2LaunchPermission(account_id string, group string) *ec2.LaunchPermission {
+	if group != "" {
+		return &ec2.LaunchPermission{Group: aws.String(group)}
+	}
+	return &ec2.LaunchPermission{UserId: aws.String(account_id)}
+}
@@ -30,6 +30,27 @@ func TestAccAWSAMILaunchPermission_Basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSAMILaunchPermission_Group(t *testing.T) {
+	resourceName := "aws_ami_launch_permission.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAMILaunchPermissionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAMILaunchPermissionConfigGroup(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAMILaunchPermissionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "group", "all"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSAMILaunchPermission_Disappears_LaunchPermission(t *testing.T) {
 	resourceName := "aws_ami_launch_permission.test"
 	rName := acctest.RandomWithPrefix("tf-acc-test")
@@ -136,9 +157,10 @@ func testAccCheckAWSAMILaunchPermissionExists(resourceName string) resource.Test
 
 		conn := testAccProvider.Meta().(*AWSClient).ec2conn
 		accountID := rs.Primary.Attributes["account_id"]
+		group := rs.Primary.Attributes["group"]
 		imageID := rs.Primary.Attributes["image_id"]
 
-		if has, err := hasLaunchPermission(conn, imageID, accountID); err != nil {
+		if has, err := hasLaunchPermission(conn, imageID, accountID, group); err != nil {
 			return err
 		} else if !has {
 			return fmt.Errorf("launch permission does not exist for '%s' on '%s'", accountID, imageID)
@@ -155,9 +177,10 @@ func testAccCheckAWSAMILaunchPermissionDestroy(s *terraform.State) error {
 
 		conn := testAccProvider.Meta().(*AWSClient).ec2conn
 		accountID := rs.Primary.Attributes["account_id"]
+		group := rs.Primary.Attributes["group"]
 		imageID := rs.Primary.Attributes["image_id"]
 
-		if has, err := hasLaunchPermission(conn, imageID, accountID); err != nil {
+		if has, err := hasLaunchPermission(conn, imageID, accountID, group); err != nil {
 			return err
 		} else if has {
 			return fmt.Errorf("launch permission still exists for '%s' on '%s'", accountID, imageID)
@@ -283,3 +306,36 @@ resource "aws_ami_launch_permission" "test" {
 }
 `, rName, rName)
 }
+
+func testAccAWSAMILaunchPermissionConfigGroup(rName string) string {
+	return fmt.Sprintf(`
+data "aws_ami" "amzn-ami-minimal-hvm" {
+  most_recent = true
+  owners      = ["amazon"]
+
+  filter {
+    name   = "name"
+    values = ["amzn-ami-minimal-hvm-*"]
+  }
+
+  filter {
+    name   = "root-device-type"
+    values = ["ebs"]
+  }
+}
+
+data "aws_region" "current" {}
+
+resource "aws_ami_copy" "test" {
+  description       = %[1]q
+  name              = %[1]q
+  source_ami_id     = "${data.aws_ami.amzn-ami-minimal-hvm.id}"
+  source_ami_region = "${data.aws_region.current.name}"
+}
+
+resource "aws_ami_launch_permission" "test" {
+  group    = "all"
+  image_id = "${aws_ami_copy.test.id}"
+}
+`, rName)
+}
@@ -3,14 +3,18 @@ package aws
 import (
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigateway"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
+// apiGatewayAccountEventualConsistencyErrors are returned until the CloudWatch role and its policies propagate.
+var apiGatewayAccountEventualConsistencyErrors = []awsEventualConsistencyError{
+	{code: apigateway.ErrCodeBadRequestException, message: "The role ARN does not have required permissions set to API Gateway"},
+	{code: apigateway.ErrCodeBadRequestException, message: "API Gateway could not successfully write to CloudWatch Logs using the ARN specified"},
+}
+
 func resourceAwsApiGatewayAccount() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsApiGatewayAccountUpdate,
@@ -93,24 +97,9 @@ func resourceAwsApiGatewayAccountUpdate(d *schema.ResourceData, meta interface{}
 	log.Printf("[INFO] Updating API Gateway Account: %s", input)
 
 	// Retry due to eventual consistency of IAM
-	expectedErrMsg := "The role ARN does not have required permissions set to API Gateway"
-	otherErrMsg := "API Gateway could not successfully write to CloudWatch Logs using the ARN specified"
-	var out *apigateway.Account
-	var err error
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
-		out, err = conn.UpdateAccount(&input)
-
-		if err != nil {
-			if isAWSErr(err, "BadRequestException", expectedErrMsg) ||
-				isAWSErr(err, "BadRequestException", otherErrMsg) {
-				log.Printf("[DEBUG] Retrying API Gateway Account update: %s", err)
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
-	})
+	out, err := retryOnAwsEventualConsistencyErrors(meta.(*AWSClient).eventualConsistencyTimeout, func() (interface{}, error) {
+		return conn.UpdateAccount(&input)
+	}, apiGatewayAccountEventualConsistencyErrors...)
 	if err != nil {
 		return fmt.Errorf("Updating API Gateway Account failed: %s", err)
 	}
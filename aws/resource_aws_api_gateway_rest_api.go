@@ -59,6 +59,16 @@ func resourceAwsApiGatewayRestApi() *schema.Resource {
 				Optional: true,
 			},
 
+			"put_rest_api_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  apigateway.PutModeOverwrite,
+				ValidateFunc: validation.StringInSlice([]string{
+					apigateway.PutModeMerge,
+					apigateway.PutModeOverwrite,
+				}, false),
+			},
+
 			"minimum_compression_size": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -156,7 +166,7 @@ func resourceAwsApiGatewayRestApiCreate(d *schema.ResourceData, meta interface{}
 		log.Printf("[DEBUG] Initializing API Gateway from OpenAPI spec %s", d.Id())
 		_, err := conn.PutRestApi(&apigateway.PutRestApiInput{
 			RestApiId: gateway.Id,
-			Mode:      aws.String(apigateway.PutModeOverwrite),
+			Mode:      aws.String(d.Get("put_rest_api_mode").(string)),
 			Body:      []byte(body.(string)),
 		})
 		if err != nil {
@@ -347,7 +357,7 @@ func resourceAwsApiGatewayRestApiUpdate(d *schema.ResourceData, meta interface{}
 			log.Printf("[DEBUG] Updating API Gateway from OpenAPI spec: %s", d.Id())
 			_, err := conn.PutRestApi(&apigateway.PutRestApiInput{
 				RestApiId: aws.String(d.Id()),
-				Mode:      aws.String(apigateway.PutModeOverwrite),
+				Mode:      aws.String(d.Get("put_rest_api_mode").(string)),
 				Body:      []byte(body.(string)),
 			})
 			if err != nil {
@@ -87,9 +87,10 @@ func TestAccAWSAPIGatewayRestApi_basic(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      "aws_api_gateway_rest_api.test",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "aws_api_gateway_rest_api.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"put_rest_api_mode"},
 			},
 
 			{
@@ -140,9 +141,10 @@ func TestAccAWSAPIGatewayRestApi_EndpointConfiguration(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      "aws_api_gateway_rest_api.test",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "aws_api_gateway_rest_api.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"put_rest_api_mode"},
 			},
 			// For backwards compatibility, test removing endpoint_configuration, which should do nothing
 			{
@@ -239,9 +241,10 @@ func TestAccAWSAPIGatewayRestApi_EndpointConfiguration_Private(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      "aws_api_gateway_rest_api.test",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "aws_api_gateway_rest_api.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"put_rest_api_mode"},
 			},
 		},
 	})
@@ -262,9 +265,10 @@ func TestAccAWSAPIGatewayRestApi_api_key_source(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      "aws_api_gateway_rest_api.test",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "aws_api_gateway_rest_api.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"put_rest_api_mode"},
 			},
 			{
 				Config: testAccAWSAPIGatewayRestAPIConfigWithUpdateAPIKeySource,
@@ -297,9 +301,10 @@ func TestAccAWSAPIGatewayRestApi_policy(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      "aws_api_gateway_rest_api.test",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "aws_api_gateway_rest_api.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"put_rest_api_mode"},
 			},
 			{
 				Config: testAccAWSAPIGatewayRestAPIConfigUpdatePolicy,
@@ -342,7 +347,7 @@ func TestAccAWSAPIGatewayRestApi_openapi(t *testing.T) {
 				ResourceName:            "aws_api_gateway_rest_api.test",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body"},
+				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
 			},
 			{
 				Config: testAccAWSAPIGatewayRestAPIUpdateConfigOpenAPI,
@@ -359,6 +364,41 @@ func TestAccAWSAPIGatewayRestApi_openapi(t *testing.T) {
 	})
 }
 
+func TestAccAWSAPIGatewayRestApi_openapi_PutRestApiMode(t *testing.T) {
+	var conf apigateway.RestApi
+	resourceName := "aws_api_gateway_rest_api.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayRestAPIDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAPIGatewayRestAPIConfigOpenAPI,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayRestAPIExists(resourceName, &conf),
+					testAccCheckAWSAPIGatewayRestAPIRoutes(&conf, []string{"/", "/test"}),
+					resource.TestCheckResourceAttr(resourceName, "put_rest_api_mode", apigateway.PutModeOverwrite),
+				),
+			},
+			{
+				Config: testAccAWSAPIGatewayRestAPIConfigOpenAPIPutRestApiModeMerge,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayRestAPIExists(resourceName, &conf),
+					testAccCheckAWSAPIGatewayRestAPIRoutes(&conf, []string{"/", "/test", "/update"}),
+					resource.TestCheckResourceAttr(resourceName, "put_rest_api_mode", apigateway.PutModeMerge),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+			},
+		},
+	})
+}
+
 func testAccCheckAWSAPIGatewayRestAPINameAttribute(conf *apigateway.RestApi, name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if *conf.Name != name {
@@ -672,3 +712,44 @@ resource "aws_api_gateway_rest_api" "test" {
 EOF
 }
 `
+
+const testAccAWSAPIGatewayRestAPIConfigOpenAPIPutRestApiModeMerge = `
+resource "aws_api_gateway_rest_api" "test" {
+  name              = "test"
+  put_rest_api_mode = "merge"
+
+  body = <<EOF
+{
+  "swagger": "2.0",
+  "info": {
+    "title": "test",
+    "version": "2017-04-20T04:08:08Z"
+  },
+  "schemes": [
+    "https"
+  ],
+  "paths": {
+    "/update": {
+      "get": {
+        "responses": {
+          "200": {
+            "description": "200 response"
+          }
+        },
+        "x-amazon-apigateway-integration": {
+          "type": "HTTP",
+          "uri": "https://www.google.de",
+          "httpMethod": "GET",
+          "responses": {
+            "default": {
+              "statusCode": 200
+            }
+          }
+        }
+      }
+    }
+  }
+}
+EOF
+}
+`
@@ -0,0 +1,181 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsApiGatewayV2DomainName() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsApiGatewayV2DomainNameCreate,
+		Read:   resourceAwsApiGatewayV2DomainNameRead,
+		Update: resourceAwsApiGatewayV2DomainNameUpdate,
+		Delete: resourceAwsApiGatewayV2DomainNameDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"api_mapping_selection_expression": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			"domain_name_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"certificate_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+						"endpoint_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								apigatewayv2.EndpointTypeRegional,
+							}, false),
+						},
+						"hosted_zone_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_domain_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsApiGatewayV2DomainNameCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn
+
+	req := &apigatewayv2.CreateDomainNameInput{
+		DomainName:               aws.String(d.Get("domain_name").(string)),
+		DomainNameConfigurations: expandApiGatewayV2DomainNameConfiguration(d.Get("domain_name_configuration").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Creating API Gateway v2 domain name: %s", req)
+	resp, err := conn.CreateDomainName(req)
+	if err != nil {
+		return fmt.Errorf("error creating API Gateway v2 domain name: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.DomainName))
+
+	return resourceAwsApiGatewayV2DomainNameRead(d, meta)
+}
+
+func resourceAwsApiGatewayV2DomainNameRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn
+
+	resp, err := conn.GetDomainName(&apigatewayv2.GetDomainNameInput{
+		DomainName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] API Gateway v2 domain name (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading API Gateway v2 domain name (%s): %s", d.Id(), err)
+	}
+
+	d.Set("api_mapping_selection_expression", resp.ApiMappingSelectionExpression)
+	domainNameArn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "apigateway",
+		Region:    meta.(*AWSClient).region,
+		Resource:  fmt.Sprintf("/domainnames/%s", d.Id()),
+	}.String()
+	d.Set("arn", domainNameArn)
+	d.Set("domain_name", resp.DomainName)
+	if err := d.Set("domain_name_configuration", flattenApiGatewayV2DomainNameConfiguration(resp.DomainNameConfigurations)); err != nil {
+		return fmt.Errorf("error setting domain_name_configuration: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsApiGatewayV2DomainNameUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn
+
+	req := &apigatewayv2.UpdateDomainNameInput{
+		DomainName:               aws.String(d.Id()),
+		DomainNameConfigurations: expandApiGatewayV2DomainNameConfiguration(d.Get("domain_name_configuration").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Updating API Gateway v2 domain name: %s", req)
+	_, err := conn.UpdateDomainName(req)
+	if err != nil {
+		return fmt.Errorf("error updating API Gateway v2 domain name (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsApiGatewayV2DomainNameRead(d, meta)
+}
+
+func resourceAwsApiGatewayV2DomainNameDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn
+
+	log.Printf("[DEBUG] Deleting API Gateway v2 domain name (%s)", d.Id())
+	_, err := conn.DeleteDomainName(&apigatewayv2.DeleteDomainNameInput{
+		DomainName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting API Gateway v2 domain name (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandApiGatewayV2DomainNameConfiguration(vDomainNameConfiguration []interface{}) []*apigatewayv2.DomainNameConfiguration {
+	if len(vDomainNameConfiguration) == 0 || vDomainNameConfiguration[0] == nil {
+		return nil
+	}
+	mDomainNameConfiguration := vDomainNameConfiguration[0].(map[string]interface{})
+
+	return []*apigatewayv2.DomainNameConfiguration{{
+		CertificateArn: aws.String(mDomainNameConfiguration["certificate_arn"].(string)),
+		EndpointType:   aws.String(mDomainNameConfiguration["endpoint_type"].(string)),
+	}}
+}
+
+func flattenApiGatewayV2DomainNameConfiguration(domainNameConfigurations []*apigatewayv2.DomainNameConfiguration) []interface{} {
+	if len(domainNameConfigurations) == 0 || domainNameConfigurations[0] == nil {
+		return []interface{}{}
+	}
+	domainNameConfiguration := domainNameConfigurations[0]
+
+	return []interface{}{map[string]interface{}{
+		"certificate_arn":    aws.StringValue(domainNameConfiguration.CertificateArn),
+		"endpoint_type":      aws.StringValue(domainNameConfiguration.EndpointType),
+		"hosted_zone_id":     aws.StringValue(domainNameConfiguration.HostedZoneId),
+		"target_domain_name": aws.StringValue(domainNameConfiguration.ApiGatewayDomainName),
+	}}
+}
@@ -0,0 +1,127 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAPIGatewayV2DomainName_basic(t *testing.T) {
+	certificateArn := testAccAWSAPIGatewayV2DomainNameCertificateArnFromEnv(t)
+
+	var v apigatewayv2.GetDomainNameOutput
+	resourceName := "aws_apigatewayv2_domain_name.test"
+	domainName := fmt.Sprintf("tf-acc-%s.terraformtest.com", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayV2DomainNameDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAPIGatewayV2DomainNameConfig_basic(domainName, certificateArn),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2DomainNameExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "api_mapping_selection_expression", "$request.basepath"),
+					testAccCheckResourceAttrRegionalARNNoAccount(resourceName, "arn", "apigateway", fmt.Sprintf("/domainnames/%s", domainName)),
+					resource.TestCheckResourceAttr(resourceName, "domain_name", domainName),
+					resource.TestCheckResourceAttr(resourceName, "domain_name_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "domain_name_configuration.0.certificate_arn", certificateArn),
+					resource.TestCheckResourceAttr(resourceName, "domain_name_configuration.0.endpoint_type", apigatewayv2.EndpointTypeRegional),
+					resource.TestMatchResourceAttr(resourceName, "domain_name_configuration.0.hosted_zone_id", regexp.MustCompile(`^Z`)),
+					resource.TestMatchResourceAttr(resourceName, "domain_name_configuration.0.target_domain_name", regexp.MustCompile(`.*\.execute-api\..*`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAPIGatewayV2DomainNameDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_apigatewayv2_domain_name" {
+			continue
+		}
+
+		_, err := conn.GetDomainName(&apigatewayv2.GetDomainNameInput{
+			DomainName: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("API Gateway v2 domain name %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAPIGatewayV2DomainNameExists(n string, v *apigatewayv2.GetDomainNameOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No API Gateway v2 domain name ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+
+		resp, err := conn.GetDomainName(&apigatewayv2.GetDomainNameInput{
+			DomainName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*v = *resp
+
+		return nil
+	}
+}
+
+// testAccAWSAPIGatewayV2DomainNameCertificateArnFromEnv skips the calling test
+// unless an ISSUED regional ACM certificate ARN has been supplied.
+func testAccAWSAPIGatewayV2DomainNameCertificateArnFromEnv(t *testing.T) string {
+	certificateArn := os.Getenv("AWS_API_GATEWAY_DOMAIN_NAME_REGIONAL_CERTIFICATE_ARN")
+	if certificateArn == "" {
+		t.Skip(
+			"Environment variable AWS_API_GATEWAY_DOMAIN_NAME_REGIONAL_CERTIFICATE_ARN is not set. " +
+				"This environment variable must be set to the ARN of " +
+				"an ISSUED ACM certificate in the region where this test " +
+				"is running to enable the test.")
+	}
+
+	return certificateArn
+}
+
+func testAccAWSAPIGatewayV2DomainNameConfig_basic(domainName, certificateArn string) string {
+	return fmt.Sprintf(`
+resource "aws_apigatewayv2_domain_name" "test" {
+  domain_name = %[1]q
+
+  domain_name_configuration {
+    certificate_arn = %[2]q
+    endpoint_type   = "REGIONAL"
+  }
+}
+`, domainName, certificateArn)
+}
@@ -73,20 +73,9 @@ func resourceAwsAppautoscalingTargetPut(d *schema.ResourceData, meta interface{}
 	}
 
 	log.Printf("[DEBUG] Application autoscaling target create configuration %s", targetOpts)
-	var err error
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
-		_, err = conn.RegisterScalableTarget(&targetOpts)
-
-		if err != nil {
-			if isAWSErr(err, "ValidationException", "Unable to assume IAM role") {
-				log.Printf("[DEBUG] Retrying creation of Application Autoscaling Scalable Target due to possible issues with IAM: %s", err)
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
-	})
+	_, err := retryOnAwsEventualConsistencyErrors(meta.(*AWSClient).eventualConsistencyTimeout, func() (interface{}, error) {
+		return conn.RegisterScalableTarget(&targetOpts)
+	}, awsEventualConsistencyError{code: "ValidationException", message: "Unable to assume IAM role"})
 	if err != nil {
 		return fmt.Errorf("Error creating application autoscaling target: %s", err)
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/structure"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsCloudFormationStack() *schema.Resource {
@@ -156,7 +157,7 @@ func resourceAwsCloudFormationStackCreate(d *schema.ResourceData, meta interface
 		input.StackPolicyURL = aws.String(v.(string))
 	}
 	if v, ok := d.GetOk("tags"); ok {
-		input.Tags = expandCloudFormationTags(v.(map[string]interface{}))
+		input.Tags = keyvaluetags.New(v.(map[string]interface{})).CloudformationTags()
 	}
 	if v, ok := d.GetOk("timeout_in_minutes"); ok {
 		m := int64(v.(int))
@@ -332,7 +333,7 @@ func resourceAwsCloudFormationStackRead(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
-	err = d.Set("tags", flattenCloudFormationTags(stack.Tags))
+	err = d.Set("tags", keyvaluetags.CloudformationKeyValueTags(stack.Tags).Map())
 	if err != nil {
 		return err
 	}
@@ -386,7 +387,7 @@ func resourceAwsCloudFormationStackUpdate(d *schema.ResourceData, meta interface
 	}
 
 	if v, ok := d.GetOk("tags"); ok {
-		input.Tags = expandCloudFormationTags(v.(map[string]interface{}))
+		input.Tags = keyvaluetags.New(v.(map[string]interface{})).CloudformationTags()
 	}
 
 	if d.HasChange("policy_body") {
@@ -3,15 +3,19 @@ package aws
 import (
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudtrail"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 )
 
+// cloudTrailEventualConsistencyErrors are returned until the CloudWatch Logs role and its policies propagate.
+var cloudTrailEventualConsistencyErrors = []awsEventualConsistencyError{
+	{code: cloudtrail.ErrCodeInvalidCloudWatchLogsRoleArnException, message: "Access denied."},
+	{code: cloudtrail.ErrCodeInvalidCloudWatchLogsLogGroupArnException, message: "Access denied."},
+}
+
 func resourceAwsCloudTrail() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsCloudTrailCreate,
@@ -172,25 +176,15 @@ func resourceAwsCloudTrailCreate(d *schema.ResourceData, meta interface{}) error
 		input.SnsTopicName = aws.String(v.(string))
 	}
 
-	var t *cloudtrail.CreateTrailOutput
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-		var err error
-		t, err = conn.CreateTrail(&input)
-		if err != nil {
-			if isAWSErr(err, cloudtrail.ErrCodeInvalidCloudWatchLogsRoleArnException, "Access denied.") {
-				return resource.RetryableError(err)
-			}
-			if isAWSErr(err, cloudtrail.ErrCodeInvalidCloudWatchLogsLogGroupArnException, "Access denied.") {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	output, err := retryOnAwsEventualConsistencyErrors(meta.(*AWSClient).eventualConsistencyTimeout, func() (interface{}, error) {
+		return conn.CreateTrail(&input)
+	}, cloudTrailEventualConsistencyErrors...)
 	if err != nil {
 		return err
 	}
 
+	t := output.(*cloudtrail.CreateTrailOutput)
+
 	log.Printf("[DEBUG] CloudTrail created: %s", t)
 
 	d.Set("arn", t.TrailARN)
@@ -343,25 +337,15 @@ func resourceAwsCloudTrailUpdate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	log.Printf("[DEBUG] Updating CloudTrail: %s", input)
-	var t *cloudtrail.UpdateTrailOutput
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-		var err error
-		t, err = conn.UpdateTrail(&input)
-		if err != nil {
-			if isAWSErr(err, cloudtrail.ErrCodeInvalidCloudWatchLogsRoleArnException, "Access denied.") {
-				return resource.RetryableError(err)
-			}
-			if isAWSErr(err, cloudtrail.ErrCodeInvalidCloudWatchLogsLogGroupArnException, "Access denied.") {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	output, err := retryOnAwsEventualConsistencyErrors(meta.(*AWSClient).eventualConsistencyTimeout, func() (interface{}, error) {
+		return conn.UpdateTrail(&input)
+	}, cloudTrailEventualConsistencyErrors...)
 	if err != nil {
 		return err
 	}
 
+	t := output.(*cloudtrail.UpdateTrailOutput)
+
 	if d.HasChange("tags") {
 		err := setTagsCloudtrail(conn, d)
 		if err != nil {
@@ -3,8 +3,6 @@ package aws
 import (
 	"fmt"
 	"log"
-	"regexp"
-	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -15,6 +13,12 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 )
 
+// cloudWatchEventRuleEventualConsistencyErrors are returned until the rule role propagates.
+var cloudWatchEventRuleEventualConsistencyErrors = []awsEventualConsistencyError{
+	// ValidationException: Provided role 'arn:aws:iam::123456789012:role/example' cannot be assumed by principal 'events.amazonaws.com'.
+	{code: "ValidationException", message: "cannot be assumed by principal"},
+}
+
 func resourceAwsCloudWatchEventRule() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsCloudWatchEventRuleCreate,
@@ -97,26 +101,15 @@ func resourceAwsCloudWatchEventRuleCreate(d *schema.ResourceData, meta interface
 	log.Printf("[DEBUG] Creating CloudWatch Event Rule: %s", input)
 
 	// IAM Roles take some time to propagate
-	var out *events.PutRuleOutput
-	err = resource.Retry(30*time.Second, func() *resource.RetryError {
-		var err error
-		out, err = conn.PutRule(input)
-		pattern := regexp.MustCompile(`cannot be assumed by principal '[a-z]+\.amazonaws\.com'\.$`)
-		if err != nil {
-			if awsErr, ok := err.(awserr.Error); ok {
-				if awsErr.Code() == "ValidationException" && pattern.MatchString(awsErr.Message()) {
-					log.Printf("[DEBUG] Retrying creation of CloudWatch Event Rule %q", *input.Name)
-					return resource.RetryableError(err)
-				}
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	output, err := retryOnAwsEventualConsistencyErrors(meta.(*AWSClient).eventualConsistencyTimeout, func() (interface{}, error) {
+		return conn.PutRule(input)
+	}, cloudWatchEventRuleEventualConsistencyErrors...)
 	if err != nil {
 		return fmt.Errorf("Creating CloudWatch Event Rule failed: %s", err)
 	}
 
+	out := output.(*events.PutRuleOutput)
+
 	d.Set("arn", out.RuleArn)
 	d.SetId(*input.Name)
 
@@ -195,20 +188,9 @@ func resourceAwsCloudWatchEventRuleUpdate(d *schema.ResourceData, meta interface
 	log.Printf("[DEBUG] Updating CloudWatch Event Rule: %s", input)
 
 	// IAM Roles take some time to propagate
-	err = resource.Retry(30*time.Second, func() *resource.RetryError {
-		_, err := conn.PutRule(input)
-		pattern := regexp.MustCompile(`cannot be assumed by principal '[a-z]+\.amazonaws\.com'\.$`)
-		if err != nil {
-			if awsErr, ok := err.(awserr.Error); ok {
-				if awsErr.Code() == "ValidationException" && pattern.MatchString(awsErr.Message()) {
-					log.Printf("[DEBUG] Retrying update of CloudWatch Event Rule %q", *input.Name)
-					return resource.RetryableError(err)
-				}
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	_, err = retryOnAwsEventualConsistencyErrors(meta.(*AWSClient).eventualConsistencyTimeout, func() (interface{}, error) {
+		return conn.PutRule(input)
+	}, cloudWatchEventRuleEventualConsistencyErrors...)
 	if err != nil {
 		return fmt.Errorf("Updating CloudWatch Event Rule failed: %s", err)
 	}
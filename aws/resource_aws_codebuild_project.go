@@ -6,17 +6,22 @@ import (
 	"log"
 	"regexp"
 	"strconv"
-	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codebuild"
 	"github.com/hashicorp/terraform/helper/customdiff"
 	"github.com/hashicorp/terraform/helper/hashcode"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 )
 
+// codeBuildProjectEventualConsistencyErrors are returned until the service role and its policies propagate.
+var codeBuildProjectEventualConsistencyErrors = []awsEventualConsistencyError{
+	// InvalidInputException: CodeBuild is not authorized to perform
+	// InvalidInputException: Not authorized to perform DescribeSecurityGroups
+	{code: codebuild.ErrCodeInvalidInputException, message: "ot authorized to perform"},
+}
+
 func resourceAwsCodeBuildProject() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsCodeBuildProjectCreate,
@@ -514,30 +519,16 @@ func resourceAwsCodeBuildProjectCreate(d *schema.ResourceData, meta interface{})
 		params.Tags = tagsFromMapCodeBuild(v.(map[string]interface{}))
 	}
 
-	var resp *codebuild.CreateProjectOutput
 	// Handle IAM eventual consistency
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-		var err error
-
-		resp, err = conn.CreateProject(params)
-		if err != nil {
-			// InvalidInputException: CodeBuild is not authorized to perform
-			// InvalidInputException: Not authorized to perform DescribeSecurityGroups
-			if isAWSErr(err, "InvalidInputException", "ot authorized to perform") {
-				return resource.RetryableError(err)
-			}
-
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
-
-	})
+	output, err := retryOnAwsEventualConsistencyErrors(meta.(*AWSClient).eventualConsistencyTimeout, func() (interface{}, error) {
+		return conn.CreateProject(params)
+	}, codeBuildProjectEventualConsistencyErrors...)
 
 	if err != nil {
 		return fmt.Errorf("Error creating CodeBuild project: %s", err)
 	}
 
+	resp := output.(*codebuild.CreateProjectOutput)
 	d.SetId(*resp.Project.Arn)
 
 	return resourceAwsCodeBuildProjectRead(d, meta)
@@ -909,23 +900,9 @@ func resourceAwsCodeBuildProjectUpdate(d *schema.ResourceData, meta interface{})
 	params.Tags = tagsFromMapCodeBuild(d.Get("tags").(map[string]interface{}))
 
 	// Handle IAM eventual consistency
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-		var err error
-
-		_, err = conn.UpdateProject(params)
-		if err != nil {
-			// InvalidInputException: CodeBuild is not authorized to perform
-			// InvalidInputException: Not authorized to perform DescribeSecurityGroups
-			if isAWSErr(err, "InvalidInputException", "ot authorized to perform") {
-				return resource.RetryableError(err)
-			}
-
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
-
-	})
+	_, err := retryOnAwsEventualConsistencyErrors(meta.(*AWSClient).eventualConsistencyTimeout, func() (interface{}, error) {
+		return conn.UpdateProject(params)
+	}, codeBuildProjectEventualConsistencyErrors...)
 
 	if err != nil {
 		return fmt.Errorf(
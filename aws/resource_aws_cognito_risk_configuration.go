@@ -0,0 +1,534 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsCognitoRiskConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCognitoRiskConfigurationPut,
+		Read:   resourceAwsCognitoRiskConfigurationRead,
+		Update: resourceAwsCognitoRiskConfigurationPut,
+		Delete: resourceAwsCognitoRiskConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		// https://docs.aws.amazon.com/cognito-user-identity-pools/latest/APIReference/API_SetRiskConfiguration.html
+		Schema: map[string]*schema.Schema{
+			"account_takeover_risk_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"actions": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"high_action":   cognitoRiskConfigurationAccountTakeoverActionSchema(),
+									"low_action":    cognitoRiskConfigurationAccountTakeoverActionSchema(),
+									"medium_action": cognitoRiskConfigurationAccountTakeoverActionSchema(),
+								},
+							},
+						},
+						"notify_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"block_email": cognitoRiskConfigurationNotifyEmailSchema(),
+									"from": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"mfa_email":       cognitoRiskConfigurationNotifyEmailSchema(),
+									"no_action_email": cognitoRiskConfigurationNotifyEmailSchema(),
+									"reply_to": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"source_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"client_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"compromised_credentials_risk_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"actions": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"event_action": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											cognitoidentityprovider.CompromisedCredentialsEventActionTypeBlock,
+											cognitoidentityprovider.CompromisedCredentialsEventActionTypeNoAction,
+										}, false),
+									},
+								},
+							},
+						},
+						"event_filter": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									cognitoidentityprovider.EventFilterTypePasswordChange,
+									cognitoidentityprovider.EventFilterTypeSignIn,
+									cognitoidentityprovider.EventFilterTypeSignUp,
+								}, false),
+							},
+						},
+					},
+				},
+			},
+			"risk_exception_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"blocked_ip_range_list": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 20,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateCIDRNetworkAddress,
+							},
+						},
+						"skipped_ip_range_list": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 20,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateCIDRNetworkAddress,
+							},
+						},
+					},
+				},
+			},
+			"user_pool_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCognitoUserPoolId,
+			},
+		},
+	}
+}
+
+func cognitoRiskConfigurationAccountTakeoverActionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"event_action": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						cognitoidentityprovider.AccountTakeoverEventActionTypeBlock,
+						cognitoidentityprovider.AccountTakeoverEventActionTypeMfaIfConfigured,
+						cognitoidentityprovider.AccountTakeoverEventActionTypeMfaRequired,
+						cognitoidentityprovider.AccountTakeoverEventActionTypeNoAction,
+					}, false),
+				},
+				"notify": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func cognitoRiskConfigurationNotifyEmailSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"html_body": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(6, 20000),
+				},
+				"subject": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 340),
+				},
+				"text_body": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(6, 20000),
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsCognitoRiskConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	userPoolID := d.Get("user_pool_id").(string)
+	id := userPoolID
+
+	input := &cognitoidentityprovider.SetRiskConfigurationInput{
+		UserPoolId: aws.String(userPoolID),
+	}
+
+	if v, ok := d.GetOk("client_id"); ok {
+		input.ClientId = aws.String(v.(string))
+		id = fmt.Sprintf("%s/%s", userPoolID, v.(string))
+	}
+
+	if v, ok := d.GetOk("account_takeover_risk_configuration"); ok {
+		input.AccountTakeoverRiskConfiguration = expandCognitoRiskConfigurationAccountTakeoverRiskConfiguration(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("compromised_credentials_risk_configuration"); ok {
+		input.CompromisedCredentialsRiskConfiguration = expandCognitoRiskConfigurationCompromisedCredentialsRiskConfiguration(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("risk_exception_configuration"); ok {
+		input.RiskExceptionConfiguration = expandCognitoRiskConfigurationRiskExceptionConfiguration(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Setting Cognito Risk Configuration: %s", input)
+	if _, err := conn.SetRiskConfiguration(input); err != nil {
+		return fmt.Errorf("error setting Cognito Risk Configuration (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceAwsCognitoRiskConfigurationRead(d, meta)
+}
+
+func resourceAwsCognitoRiskConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	userPoolID, clientID := decodeCognitoRiskConfigurationID(d.Id())
+
+	input := &cognitoidentityprovider.DescribeRiskConfigurationInput{
+		UserPoolId: aws.String(userPoolID),
+	}
+
+	if clientID != "" {
+		input.ClientId = aws.String(clientID)
+	}
+
+	output, err := conn.DescribeRiskConfiguration(input)
+
+	if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Cognito Risk Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Cognito Risk Configuration (%s): %s", d.Id(), err)
+	}
+
+	riskConfig := output.RiskConfiguration
+
+	if riskConfig == nil {
+		log.Printf("[WARN] Cognito Risk Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("client_id", clientID)
+	d.Set("user_pool_id", userPoolID)
+
+	if err := d.Set("account_takeover_risk_configuration", flattenCognitoRiskConfigurationAccountTakeoverRiskConfiguration(riskConfig.AccountTakeoverRiskConfiguration)); err != nil {
+		return fmt.Errorf("error setting account_takeover_risk_configuration: %s", err)
+	}
+
+	if err := d.Set("compromised_credentials_risk_configuration", flattenCognitoRiskConfigurationCompromisedCredentialsRiskConfiguration(riskConfig.CompromisedCredentialsRiskConfiguration)); err != nil {
+		return fmt.Errorf("error setting compromised_credentials_risk_configuration: %s", err)
+	}
+
+	if err := d.Set("risk_exception_configuration", flattenCognitoRiskConfigurationRiskExceptionConfiguration(riskConfig.RiskExceptionConfiguration)); err != nil {
+		return fmt.Errorf("error setting risk_exception_configuration: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCognitoRiskConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	userPoolID, clientID := decodeCognitoRiskConfigurationID(d.Id())
+
+	// Setting no configurations removes the risk configuration.
+	input := &cognitoidentityprovider.SetRiskConfigurationInput{
+		UserPoolId: aws.String(userPoolID),
+	}
+
+	if clientID != "" {
+		input.ClientId = aws.String(clientID)
+	}
+
+	log.Printf("[DEBUG] Deleting Cognito Risk Configuration: %s", d.Id())
+	_, err := conn.SetRiskConfiguration(input)
+
+	if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Cognito Risk Configuration (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// decodeCognitoRiskConfigurationID returns the user pool and, for client
+// level configurations, the app client ID.
+func decodeCognitoRiskConfigurationID(id string) (string, string) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) == 1 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
+}
+
+func expandCognitoRiskConfigurationAccountTakeoverRiskConfiguration(l []interface{}) *cognitoidentityprovider.AccountTakeoverRiskConfigurationType {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &cognitoidentityprovider.AccountTakeoverRiskConfigurationType{
+		Actions: &cognitoidentityprovider.AccountTakeoverActionsType{},
+	}
+
+	if v, ok := m["actions"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		actions := v[0].(map[string]interface{})
+		config.Actions.HighAction = expandCognitoRiskConfigurationAccountTakeoverAction(actions["high_action"].([]interface{}))
+		config.Actions.LowAction = expandCognitoRiskConfigurationAccountTakeoverAction(actions["low_action"].([]interface{}))
+		config.Actions.MediumAction = expandCognitoRiskConfigurationAccountTakeoverAction(actions["medium_action"].([]interface{}))
+	}
+
+	if v, ok := m["notify_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		notify := v[0].(map[string]interface{})
+
+		config.NotifyConfiguration = &cognitoidentityprovider.NotifyConfigurationType{
+			BlockEmail:    expandCognitoRiskConfigurationNotifyEmail(notify["block_email"].([]interface{})),
+			MfaEmail:      expandCognitoRiskConfigurationNotifyEmail(notify["mfa_email"].([]interface{})),
+			NoActionEmail: expandCognitoRiskConfigurationNotifyEmail(notify["no_action_email"].([]interface{})),
+			SourceArn:     aws.String(notify["source_arn"].(string)),
+		}
+
+		if v, ok := notify["from"].(string); ok && v != "" {
+			config.NotifyConfiguration.From = aws.String(v)
+		}
+
+		if v, ok := notify["reply_to"].(string); ok && v != "" {
+			config.NotifyConfiguration.ReplyTo = aws.String(v)
+		}
+	}
+
+	return config
+}
+
+func expandCognitoRiskConfigurationAccountTakeoverAction(l []interface{}) *cognitoidentityprovider.AccountTakeoverActionType {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &cognitoidentityprovider.AccountTakeoverActionType{
+		EventAction: aws.String(m["event_action"].(string)),
+		Notify:      aws.Bool(m["notify"].(bool)),
+	}
+}
+
+func expandCognitoRiskConfigurationNotifyEmail(l []interface{}) *cognitoidentityprovider.NotifyEmailType {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &cognitoidentityprovider.NotifyEmailType{
+		HtmlBody: aws.String(m["html_body"].(string)),
+		Subject:  aws.String(m["subject"].(string)),
+		TextBody: aws.String(m["text_body"].(string)),
+	}
+}
+
+func expandCognitoRiskConfigurationCompromisedCredentialsRiskConfiguration(l []interface{}) *cognitoidentityprovider.CompromisedCredentialsRiskConfigurationType {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &cognitoidentityprovider.CompromisedCredentialsRiskConfigurationType{
+		Actions: &cognitoidentityprovider.CompromisedCredentialsActionsType{},
+	}
+
+	if v, ok := m["actions"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		actions := v[0].(map[string]interface{})
+		config.Actions.EventAction = aws.String(actions["event_action"].(string))
+	}
+
+	if v, ok := m["event_filter"].(*schema.Set); ok && v.Len() > 0 {
+		config.EventFilter = expandStringSet(v)
+	}
+
+	return config
+}
+
+func expandCognitoRiskConfigurationRiskExceptionConfiguration(l []interface{}) *cognitoidentityprovider.RiskExceptionConfigurationType {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &cognitoidentityprovider.RiskExceptionConfigurationType{}
+
+	if v, ok := m["blocked_ip_range_list"].(*schema.Set); ok && v.Len() > 0 {
+		config.BlockedIPRangeList = expandStringSet(v)
+	}
+
+	if v, ok := m["skipped_ip_range_list"].(*schema.Set); ok && v.Len() > 0 {
+		config.SkippedIPRangeList = expandStringSet(v)
+	}
+
+	return config
+}
+
+func flattenCognitoRiskConfigurationAccountTakeoverRiskConfiguration(config *cognitoidentityprovider.AccountTakeoverRiskConfigurationType) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if actions := config.Actions; actions != nil {
+		m["actions"] = []interface{}{
+			map[string]interface{}{
+				"high_action":   flattenCognitoRiskConfigurationAccountTakeoverAction(actions.HighAction),
+				"low_action":    flattenCognitoRiskConfigurationAccountTakeoverAction(actions.LowAction),
+				"medium_action": flattenCognitoRiskConfigurationAccountTakeoverAction(actions.MediumAction),
+			},
+		}
+	}
+
+	if notify := config.NotifyConfiguration; notify != nil {
+		m["notify_configuration"] = []interface{}{
+			map[string]interface{}{
+				"block_email":     flattenCognitoRiskConfigurationNotifyEmail(notify.BlockEmail),
+				"from":            aws.StringValue(notify.From),
+				"mfa_email":       flattenCognitoRiskConfigurationNotifyEmail(notify.MfaEmail),
+				"no_action_email": flattenCognitoRiskConfigurationNotifyEmail(notify.NoActionEmail),
+				"reply_to":        aws.StringValue(notify.ReplyTo),
+				"source_arn":      aws.StringValue(notify.SourceArn),
+			},
+		}
+	}
+
+	return []interface{}{m}
+}
+
+func flattenCognitoRiskConfigurationAccountTakeoverAction(action *cognitoidentityprovider.AccountTakeoverActionType) []interface{} {
+	if action == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"event_action": aws.StringValue(action.EventAction),
+		"notify":       aws.BoolValue(action.Notify),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenCognitoRiskConfigurationNotifyEmail(email *cognitoidentityprovider.NotifyEmailType) []interface{} {
+	if email == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"html_body": aws.StringValue(email.HtmlBody),
+		"subject":   aws.StringValue(email.Subject),
+		"text_body": aws.StringValue(email.TextBody),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenCognitoRiskConfigurationCompromisedCredentialsRiskConfiguration(config *cognitoidentityprovider.CompromisedCredentialsRiskConfigurationType) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"event_filter": flattenStringSet(config.EventFilter),
+	}
+
+	if config.Actions != nil {
+		m["actions"] = []interface{}{
+			map[string]interface{}{
+				"event_action": aws.StringValue(config.Actions.EventAction),
+			},
+		}
+	}
+
+	return []interface{}{m}
+}
+
+func flattenCognitoRiskConfigurationRiskExceptionConfiguration(config *cognitoidentityprovider.RiskExceptionConfigurationType) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"blocked_ip_range_list": flattenStringSet(config.BlockedIPRangeList),
+		"skipped_ip_range_list": flattenStringSet(config.SkippedIPRangeList),
+	}
+
+	return []interface{}{m}
+}
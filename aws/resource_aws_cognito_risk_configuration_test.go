@@ -0,0 +1,240 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSCognitoRiskConfiguration_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cognito_risk_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoRiskConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoRiskConfigurationConfig_riskException(rName, "10.10.10.10/32"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoRiskConfigurationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "user_pool_id", "aws_cognito_user_pool.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "risk_exception_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "risk_exception_configuration.0.blocked_ip_range_list.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "risk_exception_configuration.0.skipped_ip_range_list.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoRiskConfiguration_compromised(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cognito_risk_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoRiskConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoRiskConfigurationConfig_compromised(rName, "BLOCK"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoRiskConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "compromised_credentials_risk_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "compromised_credentials_risk_configuration.0.actions.0.event_action", "BLOCK"),
+					resource.TestCheckResourceAttr(resourceName, "compromised_credentials_risk_configuration.0.event_filter.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCognitoRiskConfigurationConfig_compromised(rName, "NO_ACTION"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoRiskConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "compromised_credentials_risk_configuration.0.actions.0.event_action", "NO_ACTION"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoRiskConfiguration_accountTakeover(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cognito_risk_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoRiskConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoRiskConfigurationConfig_accountTakeover(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoRiskConfigurationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "client_id", "aws_cognito_user_pool_client.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "account_takeover_risk_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "account_takeover_risk_configuration.0.actions.0.high_action.0.event_action", "BLOCK"),
+					resource.TestCheckResourceAttr(resourceName, "account_takeover_risk_configuration.0.actions.0.high_action.0.notify", "false"),
+					resource.TestCheckResourceAttr(resourceName, "account_takeover_risk_configuration.0.actions.0.low_action.0.event_action", "NO_ACTION"),
+					resource.TestCheckResourceAttr(resourceName, "account_takeover_risk_configuration.0.actions.0.medium_action.0.event_action", "MFA_IF_CONFIGURED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCognitoRiskConfigurationExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+		output, err := testAccDescribeCognitoRiskConfiguration(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output.RiskConfiguration == nil {
+			return fmt.Errorf("Cognito Risk Configuration (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSCognitoRiskConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cognito_risk_configuration" {
+			continue
+		}
+
+		output, err := testAccDescribeCognitoRiskConfiguration(conn, rs.Primary.ID)
+
+		if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if riskConfig := output.RiskConfiguration; riskConfig != nil && (riskConfig.AccountTakeoverRiskConfiguration != nil || riskConfig.CompromisedCredentialsRiskConfiguration != nil || riskConfig.RiskExceptionConfiguration != nil) {
+			return fmt.Errorf("Cognito Risk Configuration (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccDescribeCognitoRiskConfiguration(conn *cognitoidentityprovider.CognitoIdentityProvider, id string) (*cognitoidentityprovider.DescribeRiskConfigurationOutput, error) {
+	userPoolID, clientID := decodeCognitoRiskConfigurationID(id)
+
+	input := &cognitoidentityprovider.DescribeRiskConfigurationInput{
+		UserPoolId: aws.String(userPoolID),
+	}
+
+	if clientID != "" {
+		input.ClientId = aws.String(clientID)
+	}
+
+	return conn.DescribeRiskConfiguration(input)
+}
+
+func testAccAWSCognitoRiskConfigurationConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name = %[1]q
+
+  user_pool_add_ons {
+    advanced_security_mode = "AUDIT"
+  }
+}
+`, rName)
+}
+
+func testAccAWSCognitoRiskConfigurationConfig_riskException(rName, blockedRange string) string {
+	return testAccAWSCognitoRiskConfigurationConfigBase(rName) + fmt.Sprintf(`
+resource "aws_cognito_risk_configuration" "test" {
+  user_pool_id = "${aws_cognito_user_pool.test.id}"
+
+  risk_exception_configuration {
+    blocked_ip_range_list = [%[1]q]
+  }
+}
+`, blockedRange)
+}
+
+func testAccAWSCognitoRiskConfigurationConfig_compromised(rName, eventAction string) string {
+	return testAccAWSCognitoRiskConfigurationConfigBase(rName) + fmt.Sprintf(`
+resource "aws_cognito_risk_configuration" "test" {
+  user_pool_id = "${aws_cognito_user_pool.test.id}"
+
+  compromised_credentials_risk_configuration {
+    event_filter = ["SIGN_IN"]
+
+    actions {
+      event_action = %[1]q
+    }
+  }
+}
+`, eventAction)
+}
+
+func testAccAWSCognitoRiskConfigurationConfig_accountTakeover(rName string) string {
+	return testAccAWSCognitoRiskConfigurationConfigBase(rName) + fmt.Sprintf(`
+resource "aws_cognito_user_pool_client" "test" {
+  name         = %[1]q
+  user_pool_id = "${aws_cognito_user_pool.test.id}"
+}
+
+resource "aws_cognito_risk_configuration" "test" {
+  user_pool_id = "${aws_cognito_user_pool.test.id}"
+  client_id    = "${aws_cognito_user_pool_client.test.id}"
+
+  account_takeover_risk_configuration {
+    actions {
+      high_action {
+        event_action = "BLOCK"
+        notify       = false
+      }
+
+      low_action {
+        event_action = "NO_ACTION"
+        notify       = false
+      }
+
+      medium_action {
+        event_action = "MFA_IF_CONFIGURED"
+        notify       = false
+      }
+    }
+  }
+}
+`, rName)
+}
@@ -9,11 +9,16 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 )
 
+// cognitoUserPoolEventualConsistencyErrors are returned until the SMS role and its policies propagate.
+var cognitoUserPoolEventualConsistencyErrors = []awsEventualConsistencyError{
+	{code: cognitoidentityprovider.ErrCodeInvalidSmsRoleTrustRelationshipException, message: "Role does not have a trust relationship allowing Cognito to assume the role"},
+	{code: cognitoidentityprovider.ErrCodeInvalidSmsRoleAccessPolicyException, message: "Role does not have permission to publish with SNS"},
+}
+
 func resourceAwsCognitoUserPool() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsCognitoUserPoolCreate,
@@ -415,6 +420,20 @@ func resourceAwsCognitoUserPool() *schema.Resource {
 				ConflictsWith: []string{"alias_attributes"},
 			},
 
+			"software_token_mfa_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+
 			"user_pool_add_ons": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -568,8 +587,8 @@ func resourceAwsCognitoUserPoolCreate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if v, ok := d.GetOk("mfa_configuration"); ok {
-		params.MfaConfiguration = aws.String(v.(string))
+	if _, ok := d.GetOk("software_token_mfa_configuration"); !ok {
+		params.MfaConfiguration = aws.String(d.Get("mfa_configuration").(string))
 	}
 
 	if v, ok := d.GetOk("password_policy"); ok {
@@ -639,27 +658,25 @@ func resourceAwsCognitoUserPoolCreate(d *schema.ResourceData, meta interface{})
 
 	// IAM roles & policies can take some time to propagate and be attached
 	// to the User Pool
-	var resp *cognitoidentityprovider.CreateUserPoolOutput
-	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-		var err error
-		resp, err = conn.CreateUserPool(params)
-		if isAWSErr(err, "InvalidSmsRoleTrustRelationshipException", "Role does not have a trust relationship allowing Cognito to assume the role") {
-			log.Printf("[DEBUG] Received %s, retrying CreateUserPool", err)
-			return resource.RetryableError(err)
-		}
-		if isAWSErr(err, "InvalidSmsRoleAccessPolicyException", "Role does not have permission to publish with SNS") {
-			log.Printf("[DEBUG] Received %s, retrying CreateUserPool", err)
-			return resource.RetryableError(err)
-		}
-
-		return resource.NonRetryableError(err)
-	})
+	output, err := retryOnAwsEventualConsistencyErrors(meta.(*AWSClient).eventualConsistencyTimeout, func() (interface{}, error) {
+		return conn.CreateUserPool(params)
+	}, cognitoUserPoolEventualConsistencyErrors...)
 	if err != nil {
 		return fmt.Errorf("Error creating Cognito User Pool: %s", err)
 	}
 
+	resp := output.(*cognitoidentityprovider.CreateUserPoolOutput)
 	d.SetId(*resp.UserPool.Id)
 
+	// With software token MFA configured, the MFA settings are applied with
+	// SetUserPoolMfaConfig once the pool exists, as CreateUserPool and
+	// UpdateUserPool require SMS MFA to be configured when MFA is enabled.
+	if _, ok := d.GetOk("software_token_mfa_configuration"); ok {
+		if err := resourceAwsCognitoUserPoolSetMfaConfig(conn, d, meta.(*AWSClient).eventualConsistencyTimeout); err != nil {
+			return fmt.Errorf("Error setting Cognito User Pool (%s) MFA configuration: %s", d.Id(), err)
+		}
+	}
+
 	return resourceAwsCognitoUserPoolRead(d, meta)
 }
 
@@ -753,6 +770,27 @@ func resourceAwsCognitoUserPoolRead(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("Failed setting user_pool_add_ons: %s", err)
 	}
 
+	mfaResp, err := conn.GetUserPoolMfaConfig(&cognitoidentityprovider.GetUserPoolMfaConfigInput{
+		UserPoolId: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return fmt.Errorf("Error reading Cognito User Pool (%s) MFA configuration: %s", d.Id(), err)
+	}
+
+	softwareTokenMfaConfiguration := flattenCognitoUserPoolSoftwareTokenMfaConfiguration(mfaResp.SoftwareTokenMfaConfiguration)
+
+	// Once software token MFA has been configured, the API keeps reporting
+	// it as disabled after the block is removed. Only keep a disabled
+	// configuration in state when it is also configured.
+	if v := mfaResp.SoftwareTokenMfaConfiguration; v != nil && !aws.BoolValue(v.Enabled) && len(d.Get("software_token_mfa_configuration").([]interface{})) == 0 {
+		softwareTokenMfaConfiguration = []map[string]interface{}{}
+	}
+
+	if err := d.Set("software_token_mfa_configuration", softwareTokenMfaConfiguration); err != nil {
+		return fmt.Errorf("Failed setting software_token_mfa_configuration: %s", err)
+	}
+
 	if err := d.Set("verification_message_template", flattenCognitoUserPoolVerificationMessageTemplate(resp.UserPool.VerificationMessageTemplate)); err != nil {
 		return fmt.Errorf("Failed setting verification_message_template: %s", err)
 	}
@@ -830,8 +868,8 @@ func resourceAwsCognitoUserPoolUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if v, ok := d.GetOk("mfa_configuration"); ok {
-		params.MfaConfiguration = aws.String(v.(string))
+	if _, ok := d.GetOk("software_token_mfa_configuration"); !ok {
+		params.MfaConfiguration = aws.String(d.Get("mfa_configuration").(string))
 	}
 
 	if v, ok := d.GetOk("password_policy"); ok {
@@ -903,24 +941,21 @@ func resourceAwsCognitoUserPoolUpdate(d *schema.ResourceData, meta interface{})
 
 	// IAM roles & policies can take some time to propagate and be attached
 	// to the User Pool.
-	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-		var err error
-		_, err = conn.UpdateUserPool(params)
-		if isAWSErr(err, "InvalidSmsRoleTrustRelationshipException", "Role does not have a trust relationship allowing Cognito to assume the role") {
-			log.Printf("[DEBUG] Received %s, retrying UpdateUserPool", err)
-			return resource.RetryableError(err)
-		}
-		if isAWSErr(err, "InvalidSmsRoleAccessPolicyException", "Role does not have permission to publish with SNS") {
-			log.Printf("[DEBUG] Received %s, retrying UpdateUserPool", err)
-			return resource.RetryableError(err)
-		}
-
-		return resource.NonRetryableError(err)
-	})
+	_, err := retryOnAwsEventualConsistencyErrors(meta.(*AWSClient).eventualConsistencyTimeout, func() (interface{}, error) {
+		return conn.UpdateUserPool(params)
+	}, cognitoUserPoolEventualConsistencyErrors...)
 	if err != nil {
 		return fmt.Errorf("Error updating Cognito User pool: %s", err)
 	}
 
+	// UpdateUserPool resets MFA to its default when MfaConfiguration is
+	// omitted, so always reapply it when software token MFA is configured.
+	if _, ok := d.GetOk("software_token_mfa_configuration"); ok || d.HasChange("software_token_mfa_configuration") {
+		if err := resourceAwsCognitoUserPoolSetMfaConfig(conn, d, meta.(*AWSClient).eventualConsistencyTimeout); err != nil {
+			return fmt.Errorf("Error setting Cognito User Pool (%s) MFA configuration: %s", d.Id(), err)
+		}
+	}
+
 	return resourceAwsCognitoUserPoolRead(d, meta)
 }
 
@@ -941,3 +976,36 @@ func resourceAwsCognitoUserPoolDelete(d *schema.ResourceData, meta interface{})
 
 	return nil
 }
+
+func resourceAwsCognitoUserPoolSetMfaConfig(conn *cognitoidentityprovider.CognitoIdentityProvider, d *schema.ResourceData, timeout time.Duration) error {
+	input := &cognitoidentityprovider.SetUserPoolMfaConfigInput{
+		MfaConfiguration:              aws.String(d.Get("mfa_configuration").(string)),
+		SoftwareTokenMfaConfiguration: expandCognitoUserPoolSoftwareTokenMfaConfiguration(d.Get("software_token_mfa_configuration").([]interface{})),
+		UserPoolId:                    aws.String(d.Id()),
+	}
+
+	if v, ok := d.GetOk("sms_configuration"); ok {
+		configs := v.([]interface{})
+		config, ok := configs[0].(map[string]interface{})
+
+		if ok && config != nil {
+			input.SmsMfaConfiguration = &cognitoidentityprovider.SmsMfaConfigType{
+				SmsConfiguration: expandCognitoUserPoolSmsConfiguration(config),
+			}
+
+			if v, ok := d.GetOk("sms_authentication_message"); ok {
+				input.SmsMfaConfiguration.SmsAuthenticationMessage = aws.String(v.(string))
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] Setting Cognito User Pool MFA configuration: %s", input)
+
+	// IAM roles & policies can take some time to propagate and be attached
+	// to the User Pool.
+	_, err := retryOnAwsEventualConsistencyErrors(timeout, func() (interface{}, error) {
+		return conn.SetUserPoolMfaConfig(input)
+	}, cognitoUserPoolEventualConsistencyErrors...)
+
+	return err
+}
@@ -179,6 +179,58 @@ func TestAccAWSCognitoUserPool_withAdvancedSecurityMode(t *testing.T) {
 	})
 }
 
+func TestAccAWSCognitoUserPool_withSoftwareTokenMfaConfiguration(t *testing.T) {
+	name := acctest.RandString(5)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserPoolConfig_withSoftwareTokenMfaConfiguration(name, "ON", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolExists("aws_cognito_user_pool.pool"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "mfa_configuration", "ON"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "software_token_mfa_configuration.#", "1"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "software_token_mfa_configuration.0.enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      "aws_cognito_user_pool.pool",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCognitoUserPoolConfig_withSoftwareTokenMfaConfiguration(name, "OPTIONAL", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "mfa_configuration", "OPTIONAL"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "software_token_mfa_configuration.0.enabled", "true"),
+				),
+			},
+			{
+				Config: testAccAWSCognitoUserPoolConfig_withSoftwareTokenMfaConfiguration(name, "OFF", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "mfa_configuration", "OFF"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "software_token_mfa_configuration.#", "1"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "software_token_mfa_configuration.0.enabled", "false"),
+				),
+			},
+			{
+				Config:   testAccAWSCognitoUserPoolConfig_withSoftwareTokenMfaConfiguration(name, "OFF", false),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccAWSCognitoUserPoolConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "mfa_configuration", "OFF"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "software_token_mfa_configuration.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSCognitoUserPool_withDeviceConfiguration(t *testing.T) {
 	name := acctest.RandString(5)
 
@@ -773,6 +825,18 @@ resource "aws_cognito_user_pool" "pool" {
 }`, name, mode)
 }
 
+func testAccAWSCognitoUserPoolConfig_withSoftwareTokenMfaConfiguration(name string, mfaConfiguration string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "pool" {
+  name              = "terraform-test-pool-%s"
+  mfa_configuration = "%s"
+
+  software_token_mfa_configuration {
+    enabled = %t
+  }
+}`, name, mfaConfiguration, enabled)
+}
+
 func testAccAWSCognitoUserPoolConfig_withDeviceConfiguration(name string) string {
 	return fmt.Sprintf(`
 resource "aws_cognito_user_pool" "pool" {
@@ -0,0 +1,186 @@
+package aws
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// cognitoUserPoolUICustomizationAllClients is the client ID used for the
+// customization applied to every app client of the user pool.
+const cognitoUserPoolUICustomizationAllClients = "ALL"
+
+func resourceAwsCognitoUserPoolUICustomization() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCognitoUserPoolUICustomizationPut,
+		Read:   resourceAwsCognitoUserPoolUICustomizationRead,
+		Update: resourceAwsCognitoUserPoolUICustomizationPut,
+		Delete: resourceAwsCognitoUserPoolUICustomizationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"client_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  cognitoUserPoolUICustomizationAllClients,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"css": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"css_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"image_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_pool_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCognitoUserPoolId,
+			},
+		},
+	}
+}
+
+func resourceAwsCognitoUserPoolUICustomizationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	clientID := d.Get("client_id").(string)
+	userPoolID := d.Get("user_pool_id").(string)
+
+	input := &cognitoidentityprovider.SetUICustomizationInput{
+		ClientId:   aws.String(clientID),
+		UserPoolId: aws.String(userPoolID),
+	}
+
+	if v, ok := d.GetOk("css"); ok {
+		input.CSS = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("image_file"); ok {
+		imageFile, err := base64.StdEncoding.DecodeString(v.(string))
+		if err != nil {
+			return fmt.Errorf("error decoding image_file: %s", err)
+		}
+		input.ImageFile = imageFile
+	}
+
+	log.Printf("[DEBUG] Setting Cognito User Pool UI Customization: %s", input)
+	if _, err := conn.SetUICustomization(input); err != nil {
+		return fmt.Errorf("error setting Cognito User Pool (%s) UI Customization: %s", userPoolID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", userPoolID, clientID))
+
+	return resourceAwsCognitoUserPoolUICustomizationRead(d, meta)
+}
+
+func resourceAwsCognitoUserPoolUICustomizationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	userPoolID, clientID, err := decodeCognitoUserPoolUICustomizationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	output, err := conn.GetUICustomization(&cognitoidentityprovider.GetUICustomizationInput{
+		ClientId:   aws.String(clientID),
+		UserPoolId: aws.String(userPoolID),
+	})
+
+	if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Cognito User Pool UI Customization (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Cognito User Pool UI Customization (%s): %s", d.Id(), err)
+	}
+
+	customization := output.UICustomization
+
+	// A client without its own customization returns the user pool's one,
+	// and a user pool without any customization returns an empty one.
+	if customization == nil || (customization.CSS == nil && customization.ImageUrl == nil) || aws.StringValue(customization.ClientId) != clientID {
+		log.Printf("[WARN] Cognito User Pool UI Customization (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("client_id", customization.ClientId)
+	d.Set("css", customization.CSS)
+	d.Set("css_version", customization.CSSVersion)
+	d.Set("image_url", customization.ImageUrl)
+	d.Set("user_pool_id", customization.UserPoolId)
+
+	if customization.CreationDate != nil {
+		d.Set("creation_date", customization.CreationDate.Format(time.RFC3339))
+	}
+
+	if customization.LastModifiedDate != nil {
+		d.Set("last_modified_date", customization.LastModifiedDate.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func resourceAwsCognitoUserPoolUICustomizationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	userPoolID, clientID, err := decodeCognitoUserPoolUICustomizationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// Setting neither CSS nor an image removes the customization.
+	log.Printf("[DEBUG] Deleting Cognito User Pool UI Customization: %s", d.Id())
+	_, err = conn.SetUICustomization(&cognitoidentityprovider.SetUICustomizationInput{
+		ClientId:   aws.String(clientID),
+		UserPoolId: aws.String(userPoolID),
+	})
+
+	if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Cognito User Pool UI Customization (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func decodeCognitoUserPoolUICustomizationID(id string) (string, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("expected ID in format USER_POOL_ID/CLIENT_ID, provided: %s", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,181 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSCognitoUserPoolUICustomization_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cognito_user_pool_ui_customization.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserPoolUICustomizationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserPoolUICustomizationConfig_css(rName, ".label-customizable {font-weight: 400;}"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolUICustomizationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "client_id", "ALL"),
+					resource.TestCheckResourceAttr(resourceName, "css", ".label-customizable {font-weight: 400;}"),
+					resource.TestCheckResourceAttrSet(resourceName, "css_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date"),
+					resource.TestCheckResourceAttrPair(resourceName, "user_pool_id", "aws_cognito_user_pool.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCognitoUserPoolUICustomizationConfig_css(rName, ".label-customizable {font-weight: 100;}"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolUICustomizationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "css", ".label-customizable {font-weight: 100;}"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoUserPoolUICustomization_clientID(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cognito_user_pool_ui_customization.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserPoolUICustomizationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserPoolUICustomizationConfig_clientID(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolUICustomizationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "client_id", "aws_cognito_user_pool_client.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCognitoUserPoolUICustomizationExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		userPoolID, clientID, err := decodeCognitoUserPoolUICustomizationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+		output, err := conn.GetUICustomization(&cognitoidentityprovider.GetUICustomizationInput{
+			ClientId:   aws.String(clientID),
+			UserPoolId: aws.String(userPoolID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output.UICustomization == nil || aws.StringValue(output.UICustomization.ClientId) != clientID {
+			return fmt.Errorf("Cognito User Pool UI Customization (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSCognitoUserPoolUICustomizationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cognito_user_pool_ui_customization" {
+			continue
+		}
+
+		userPoolID, clientID, err := decodeCognitoUserPoolUICustomizationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		output, err := conn.GetUICustomization(&cognitoidentityprovider.GetUICustomizationInput{
+			ClientId:   aws.String(clientID),
+			UserPoolId: aws.String(userPoolID),
+		})
+
+		if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if customization := output.UICustomization; customization != nil && aws.StringValue(customization.ClientId) == clientID && (customization.CSS != nil || customization.ImageUrl != nil) {
+			return fmt.Errorf("Cognito User Pool UI Customization (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSCognitoUserPoolUICustomizationConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name = %[1]q
+}
+
+resource "aws_cognito_user_pool_domain" "test" {
+  domain       = %[1]q
+  user_pool_id = "${aws_cognito_user_pool.test.id}"
+}
+`, rName)
+}
+
+func testAccAWSCognitoUserPoolUICustomizationConfig_css(rName, css string) string {
+	return testAccAWSCognitoUserPoolUICustomizationConfigBase(rName) + fmt.Sprintf(`
+resource "aws_cognito_user_pool_ui_customization" "test" {
+  css = %[1]q
+
+  # Refer to the aws_cognito_user_pool_domain resource's
+  # user_pool_id attribute to ensure it is in an 'Active' state
+  user_pool_id = "${aws_cognito_user_pool_domain.test.user_pool_id}"
+}
+`, css)
+}
+
+func testAccAWSCognitoUserPoolUICustomizationConfig_clientID(rName string) string {
+	return testAccAWSCognitoUserPoolUICustomizationConfigBase(rName) + fmt.Sprintf(`
+resource "aws_cognito_user_pool_client" "test" {
+  name         = %[1]q
+  user_pool_id = "${aws_cognito_user_pool.test.id}"
+}
+
+resource "aws_cognito_user_pool_ui_customization" "test" {
+  client_id = "${aws_cognito_user_pool_client.test.id}"
+  css       = ".label-customizable {font-weight: 400;}"
+
+  # Refer to the aws_cognito_user_pool_domain resource's
+  # user_pool_id attribute to ensure it is in an 'Active' state
+  user_pool_id = "${aws_cognito_user_pool_domain.test.user_pool_id}"
+}
+`, rName)
+}
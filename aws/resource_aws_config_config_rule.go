@@ -16,6 +16,11 @@ import (
 	"github.com/aws/aws-sdk-go/service/configservice"
 )
 
+// configConfigRuleEventualConsistencyErrors are returned until the rule Lambda function permissions propagate.
+var configConfigRuleEventualConsistencyErrors = []awsEventualConsistencyError{
+	{code: configservice.ErrCodeInsufficientPermissionsException},
+}
+
 func resourceAwsConfigConfigRule() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsConfigConfigRulePut,
@@ -164,23 +169,11 @@ func resourceAwsConfigConfigRulePut(d *schema.ResourceData, meta interface{}) er
 		ConfigRule: &ruleInput,
 	}
 	log.Printf("[DEBUG] Creating AWSConfig config rule: %s", input)
-	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-		_, err := conn.PutConfigRule(&input)
-		if err != nil {
-			if awsErr, ok := err.(awserr.Error); ok {
-				if awsErr.Code() == "InsufficientPermissionsException" {
-					// IAM is eventually consistent
-					return resource.RetryableError(err)
-				}
-			}
-
-			return resource.NonRetryableError(fmt.Errorf("Failed to create AWSConfig rule: %s", err))
-		}
-
-		return nil
-	})
+	_, err := retryOnAwsEventualConsistencyErrors(meta.(*AWSClient).eventualConsistencyTimeout, func() (interface{}, error) {
+		return conn.PutConfigRule(&input)
+	}, configConfigRuleEventualConsistencyErrors...)
 	if err != nil {
-		return err
+		return fmt.Errorf("Failed to create AWSConfig rule: %s", err)
 	}
 
 	d.SetId(name)
@@ -216,23 +216,15 @@ func resourceAwsDaxClusterCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	// IAM roles take some time to propagate
-	var resp *dax.CreateClusterOutput
-	err := resource.Retry(30*time.Second, func() *resource.RetryError {
-		var err error
-		resp, err = conn.CreateCluster(req)
-		if err != nil {
-			if isAWSErr(err, dax.ErrCodeInvalidParameterValueException, "No permission to assume role") {
-				log.Print("[DEBUG] Retrying create of DAX cluster")
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	output, err := retryOnAwsEventualConsistencyErrors(meta.(*AWSClient).eventualConsistencyTimeout, func() (interface{}, error) {
+		return conn.CreateCluster(req)
+	}, awsEventualConsistencyError{code: dax.ErrCodeInvalidParameterValueException, message: "No permission to assume role"})
 	if err != nil {
 		return fmt.Errorf("Error creating DAX cluster: %s", err)
 	}
 
+	resp := output.(*dax.CreateClusterOutput)
+
 	// Assign the cluster id as the resource ID
 	// DAX always retains the id in lower case, so we have to
 	// mimic that or else we won't be able to refresh a resource whose
@@ -3,9 +3,11 @@ package aws
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -35,9 +37,10 @@ func resourceAwsDefaultSubnet() *schema.Resource {
 		Type:     schema.TypeString,
 		Computed: true,
 	}
-	// ipv6_cidr_block is a computed value for Default Subnets
+	// ipv6_cidr_block is left as-is on Default Subnets unless configured
 	dsubnet.Schema["ipv6_cidr_block"] = &schema.Schema{
 		Type:     schema.TypeString,
+		Optional: true,
 		Computed: true,
 	}
 	// map_public_ip_on_launch is a computed value for Default Subnets
@@ -46,11 +49,17 @@ func resourceAwsDefaultSubnet() *schema.Resource {
 		Optional: true,
 		Computed: true,
 	}
-	// assign_ipv6_address_on_creation is a computed value for Default Subnets
+	// assign_ipv6_address_on_creation is left as-is on Default Subnets unless configured
 	dsubnet.Schema["assign_ipv6_address_on_creation"] = &schema.Schema{
 		Type:     schema.TypeBool,
+		Optional: true,
 		Computed: true,
 	}
+	dsubnet.Schema["force_destroy"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
 
 	return dsubnet
 }
@@ -75,11 +84,80 @@ func resourceAwsDefaultSubnetCreate(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("Default subnet not found")
 	}
 
-	d.SetId(aws.StringValue(resp.Subnets[0].SubnetId))
+	subnet := resp.Subnets[0]
+	d.SetId(aws.StringValue(subnet.SubnetId))
+
+	// resourceAwsSubnetUpdate skips IPv6 CIDR block changes for new resources, so converge them here.
+	if v, ok := d.GetOk("ipv6_cidr_block"); ok {
+		if err := resourceAwsDefaultSubnetSetIpv6CidrBlock(conn, subnet, v.(string)); err != nil {
+			return err
+		}
+	}
+
 	return resourceAwsSubnetUpdate(d, meta)
 }
 
 func resourceAwsDefaultSubnetDelete(d *schema.ResourceData, meta interface{}) error {
+	if d.Get("force_destroy").(bool) {
+		return resourceAwsSubnetDelete(d, meta)
+	}
+
 	log.Printf("[WARN] Cannot destroy Default Subnet. Terraform will remove this resource from the state file, however resources may remain.")
 	return nil
 }
+
+// resourceAwsDefaultSubnetSetIpv6CidrBlock replaces the IPv6 CIDR block of an adopted Default Subnet, if it differs.
+func resourceAwsDefaultSubnetSetIpv6CidrBlock(conn *ec2.EC2, subnet *ec2.Subnet, cidrBlock string) error {
+	subnetID := aws.StringValue(subnet.SubnetId)
+
+	for _, a := range subnet.Ipv6CidrBlockAssociationSet {
+		if a.Ipv6CidrBlockState == nil || aws.StringValue(a.Ipv6CidrBlockState.State) != ec2.SubnetCidrBlockStateCodeAssociated {
+			continue
+		}
+
+		if aws.StringValue(a.Ipv6CidrBlock) == cidrBlock {
+			return nil
+		}
+
+		associationID := aws.StringValue(a.AssociationId)
+
+		log.Printf("[INFO] Disassociating IPv6 CIDR block (%s) from Default Subnet: %s", aws.StringValue(a.Ipv6CidrBlock), subnetID)
+		_, err := conn.DisassociateSubnetCidrBlock(&ec2.DisassociateSubnetCidrBlockInput{
+			AssociationId: aws.String(associationID),
+		})
+		if err != nil {
+			return fmt.Errorf("error disassociating IPv6 CIDR block from Default Subnet (%s): %s", subnetID, err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending: []string{ec2.SubnetCidrBlockStateCodeDisassociating, ec2.SubnetCidrBlockStateCodeAssociated},
+			Target:  []string{ec2.SubnetCidrBlockStateCodeDisassociated},
+			Refresh: SubnetIpv6CidrStateRefreshFunc(conn, subnetID, associationID),
+			Timeout: 3 * time.Minute,
+		}
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("error waiting for Default Subnet (%s) IPv6 CIDR to become disassociated: %s", subnetID, err)
+		}
+	}
+
+	log.Printf("[INFO] Associating IPv6 CIDR block (%s) with Default Subnet: %s", cidrBlock, subnetID)
+	resp, err := conn.AssociateSubnetCidrBlock(&ec2.AssociateSubnetCidrBlockInput{
+		SubnetId:      aws.String(subnetID),
+		Ipv6CidrBlock: aws.String(cidrBlock),
+	})
+	if err != nil {
+		return fmt.Errorf("error associating IPv6 CIDR block with Default Subnet (%s): %s", subnetID, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.SubnetCidrBlockStateCodeAssociating, ec2.SubnetCidrBlockStateCodeDisassociated},
+		Target:  []string{ec2.SubnetCidrBlockStateCodeAssociated},
+		Refresh: SubnetIpv6CidrStateRefreshFunc(conn, subnetID, aws.StringValue(resp.Ipv6CidrBlockAssociation.AssociationId)),
+		Timeout: 3 * time.Minute,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Default Subnet (%s) IPv6 CIDR to become associated: %s", subnetID, err)
+	}
+
+	return nil
+}
@@ -1,8 +1,10 @@
 package aws
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -81,11 +83,72 @@ func TestAccAWSDefaultSubnet_publicIp(t *testing.T) {
 	})
 }
 
+func TestAccAWSDefaultSubnet_forceDestroy(t *testing.T) {
+	var v ec2.Subnet
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDefaultSubnetDestroyExists("us-west-2c"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDefaultSubnetConfigForceDestroy,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubnetExists("aws_default_subnet.foo", &v),
+					resource.TestCheckResourceAttr(
+						"aws_default_subnet.foo", "availability_zone", "us-west-2c"),
+					resource.TestCheckResourceAttr(
+						"aws_default_subnet.foo", "force_destroy", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSDefaultSubnetDestroy(s *terraform.State) error {
 	// We expect subnet to still exist
 	return nil
 }
 
+// testAccCheckAWSDefaultSubnetDestroyExists verifies that a force destroyed Default Subnet
+// is gone, then recreates it so that the account's default networking is left intact.
+func testAccCheckAWSDefaultSubnetDestroyExists(availabilityZone string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_default_subnet" {
+				continue
+			}
+
+			resp, err := conn.DescribeSubnets(&ec2.DescribeSubnetsInput{
+				SubnetIds: []*string{aws.String(rs.Primary.ID)},
+			})
+
+			if isAWSErr(err, "InvalidSubnetID.NotFound", "") {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(resp.Subnets) > 0 {
+				return fmt.Errorf("Default Subnet (%s) still exists", rs.Primary.ID)
+			}
+		}
+
+		_, err := conn.CreateDefaultSubnet(&ec2.CreateDefaultSubnetInput{
+			AvailabilityZone: aws.String(availabilityZone),
+		})
+		if err != nil {
+			return fmt.Errorf("error recreating Default Subnet in %s: %s", availabilityZone, err)
+		}
+
+		return nil
+	}
+}
+
 const testAccAWSDefaultSubnetConfigBasic = `
 resource "aws_default_subnet" "foo" {
   availability_zone = "us-west-2a"
@@ -114,3 +177,13 @@ resource "aws_default_subnet" "foo" {
   }
 }
 `
+
+const testAccAWSDefaultSubnetConfigForceDestroy = `
+resource "aws_default_subnet" "foo" {
+  availability_zone = "us-west-2c"
+  force_destroy     = true
+  tags = {
+    Name = "terraform-testacc-default-subnet-force-destroy"
+  }
+}
+`
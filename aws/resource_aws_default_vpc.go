@@ -25,11 +25,17 @@ func resourceAwsDefaultVpc() *schema.Resource {
 		Type:     schema.TypeString,
 		Computed: true,
 	}
-	// assign_generated_ipv6_cidr_block is a computed value for Default VPCs
+	// assign_generated_ipv6_cidr_block is left as-is on Default VPCs unless configured
 	dvpc.Schema["assign_generated_ipv6_cidr_block"] = &schema.Schema{
 		Type:     schema.TypeBool,
+		Optional: true,
 		Computed: true,
 	}
+	dvpc.Schema["force_destroy"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
 
 	return dvpc
 }
@@ -54,12 +60,124 @@ func resourceAwsDefaultVpcCreate(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("No default VPC found in this region.")
 	}
 
-	d.SetId(aws.StringValue(resp.Vpcs[0].VpcId))
+	vpc := resp.Vpcs[0]
+	d.SetId(aws.StringValue(vpc.VpcId))
+
+	// resourceAwsVpcUpdate skips IPv6 changes for new resources, so converge them here.
+	if v, ok := d.GetOkExists("assign_generated_ipv6_cidr_block"); ok {
+		if err := resourceAwsDefaultVpcSetIpv6CidrBlock(conn, vpc, v.(bool)); err != nil {
+			return err
+		}
+	}
 
 	return resourceAwsVpcUpdate(d, meta)
 }
 
 func resourceAwsDefaultVpcDelete(d *schema.ResourceData, meta interface{}) error {
-	log.Printf("[WARN] Cannot destroy Default VPC. Terraform will remove this resource from the state file, however resources may remain.")
+	if !d.Get("force_destroy").(bool) {
+		log.Printf("[WARN] Cannot destroy Default VPC. Terraform will remove this resource from the state file, however resources may remain.")
+		return nil
+	}
+
+	conn := meta.(*AWSClient).ec2conn
+
+	// A Default VPC is created together with an Internet Gateway and a subnet per Availability Zone,
+	// all of which must be removed before the VPC itself can be deleted.
+	igwResp, err := conn.DescribeInternetGateways(&ec2.DescribeInternetGatewaysInput{
+		Filters: buildEC2AttributeFilterList(map[string]string{
+			"attachment.vpc-id": d.Id(),
+		}),
+	})
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Internet Gateways for Default VPC (%s): %s", d.Id(), err)
+	}
+
+	for _, igw := range igwResp.InternetGateways {
+		igwID := aws.StringValue(igw.InternetGatewayId)
+
+		log.Printf("[INFO] Detaching EC2 Internet Gateway (%s) from Default VPC (%s)", igwID, d.Id())
+		_, err := conn.DetachInternetGateway(&ec2.DetachInternetGatewayInput{
+			InternetGatewayId: aws.String(igwID),
+			VpcId:             aws.String(d.Id()),
+		})
+		if err != nil && !isAWSErr(err, "Gateway.NotAttached", "") {
+			return fmt.Errorf("error detaching EC2 Internet Gateway (%s) from Default VPC (%s): %s", igwID, d.Id(), err)
+		}
+
+		log.Printf("[INFO] Deleting EC2 Internet Gateway: %s", igwID)
+		_, err = conn.DeleteInternetGateway(&ec2.DeleteInternetGatewayInput{
+			InternetGatewayId: aws.String(igwID),
+		})
+		if err != nil && !isAWSErr(err, "InvalidInternetGatewayID.NotFound", "") {
+			return fmt.Errorf("error deleting EC2 Internet Gateway (%s): %s", igwID, err)
+		}
+	}
+
+	subnetResp, err := conn.DescribeSubnets(&ec2.DescribeSubnetsInput{
+		Filters: buildEC2AttributeFilterList(map[string]string{
+			"vpc-id":       d.Id(),
+			"defaultForAz": "true",
+		}),
+	})
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Subnets for Default VPC (%s): %s", d.Id(), err)
+	}
+
+	for _, subnet := range subnetResp.Subnets {
+		subnetID := aws.StringValue(subnet.SubnetId)
+
+		log.Printf("[INFO] Deleting Default Subnet: %s", subnetID)
+		_, err := conn.DeleteSubnet(&ec2.DeleteSubnetInput{
+			SubnetId: aws.String(subnetID),
+		})
+		if err != nil && !isAWSErr(err, "InvalidSubnetID.NotFound", "") {
+			return fmt.Errorf("error deleting Default Subnet (%s): %s", subnetID, err)
+		}
+	}
+
+	return resourceAwsVpcDelete(d, meta)
+}
+
+// resourceAwsDefaultVpcSetIpv6CidrBlock associates or disassociates the Amazon-provided IPv6 CIDR block of an adopted Default VPC.
+func resourceAwsDefaultVpcSetIpv6CidrBlock(conn *ec2.EC2, vpc *ec2.Vpc, assign bool) error {
+	vpcID := aws.StringValue(vpc.VpcId)
+
+	var associationID string
+	for _, a := range vpc.Ipv6CidrBlockAssociationSet {
+		if a.Ipv6CidrBlockState != nil && aws.StringValue(a.Ipv6CidrBlockState.State) == ec2.VpcCidrBlockStateCodeAssociated {
+			associationID = aws.StringValue(a.AssociationId)
+			break
+		}
+	}
+
+	if assign && associationID == "" {
+		log.Printf("[INFO] Associating Amazon-provided IPv6 CIDR block with Default VPC: %s", vpcID)
+		resp, err := conn.AssociateVpcCidrBlock(&ec2.AssociateVpcCidrBlockInput{
+			VpcId:                       aws.String(vpcID),
+			AmazonProvidedIpv6CidrBlock: aws.Bool(true),
+		})
+		if err != nil {
+			return fmt.Errorf("error associating IPv6 CIDR block with Default VPC (%s): %s", vpcID, err)
+		}
+
+		if err := waitForEc2VpcIpv6CidrBlockAssociationCreate(conn, vpcID, aws.StringValue(resp.Ipv6CidrBlockAssociation.AssociationId)); err != nil {
+			return fmt.Errorf("error waiting for Default VPC (%s) IPv6 CIDR to become associated: %s", vpcID, err)
+		}
+	}
+
+	if !assign && associationID != "" {
+		log.Printf("[INFO] Disassociating IPv6 CIDR block from Default VPC: %s", vpcID)
+		_, err := conn.DisassociateVpcCidrBlock(&ec2.DisassociateVpcCidrBlockInput{
+			AssociationId: aws.String(associationID),
+		})
+		if err != nil {
+			return fmt.Errorf("error disassociating IPv6 CIDR block from Default VPC (%s): %s", vpcID, err)
+		}
+
+		if err := waitForEc2VpcIpv6CidrBlockAssociationDelete(conn, vpcID, associationID); err != nil {
+			return fmt.Errorf("error waiting for Default VPC (%s) IPv6 CIDR to become disassociated: %s", vpcID, err)
+		}
+	}
+
 	return nil
 }
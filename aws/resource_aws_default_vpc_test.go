@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -42,6 +43,43 @@ func TestAccAWSDefaultVpc_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSDefaultVpc_ipv6(t *testing.T) {
+	var vpc ec2.Vpc
+
+	// Not parallel, as every step reconfigures the single Default VPC of the region.
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDefaultVpcDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDefaultVpcConfigIpv6(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcExists("aws_default_vpc.foo", &vpc),
+					resource.TestCheckResourceAttr(
+						"aws_default_vpc.foo", "assign_generated_ipv6_cidr_block", "true"),
+					resource.TestCheckResourceAttrSet(
+						"aws_default_vpc.foo", "ipv6_association_id"),
+					resource.TestCheckResourceAttrSet(
+						"aws_default_vpc.foo", "ipv6_cidr_block"),
+				),
+			},
+			{
+				Config: testAccAWSDefaultVpcConfigIpv6(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcExists("aws_default_vpc.foo", &vpc),
+					resource.TestCheckResourceAttr(
+						"aws_default_vpc.foo", "assign_generated_ipv6_cidr_block", "false"),
+					resource.TestCheckResourceAttr(
+						"aws_default_vpc.foo", "ipv6_association_id", ""),
+					resource.TestCheckResourceAttr(
+						"aws_default_vpc.foo", "ipv6_cidr_block", ""),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSDefaultVpcDestroy(s *terraform.State) error {
 	// We expect VPC to still exist
 	return nil
@@ -58,3 +96,19 @@ resource "aws_default_vpc" "foo" {
 	}
 }
 `
+
+func testAccAWSDefaultVpcConfigIpv6(assign bool) string {
+	return fmt.Sprintf(`
+provider "aws" {
+    region = "us-west-2"
+}
+
+resource "aws_default_vpc" "foo" {
+	assign_generated_ipv6_cidr_block = %t
+
+	tags = {
+		Name = "Default VPC"
+	}
+}
+`, assign)
+}
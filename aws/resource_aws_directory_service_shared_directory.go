@@ -0,0 +1,287 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsDirectoryServiceSharedDirectory() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDirectoryServiceSharedDirectoryCreate,
+		Read:   resourceAwsDirectoryServiceSharedDirectoryRead,
+		Delete: resourceAwsDirectoryServiceSharedDirectoryDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"method": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  directoryservice.ShareMethodHandshake,
+				ValidateFunc: validation.StringInSlice([]string{
+					directoryservice.ShareMethodHandshake,
+					directoryservice.ShareMethodOrganizations,
+				}, false),
+			},
+			"notes": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"shared_directory_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  directoryservice.TargetTypeAccount,
+							ValidateFunc: validation.StringInSlice([]string{
+								directoryservice.TargetTypeAccount,
+							}, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsDirectoryServiceSharedDirectoryCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dsconn
+
+	directoryId := d.Get("directory_id").(string)
+	input := &directoryservice.ShareDirectoryInput{
+		DirectoryId: aws.String(directoryId),
+		ShareMethod: aws.String(d.Get("method").(string)),
+		ShareTarget: expandDirectoryServiceShareTarget(d.Get("target").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("notes"); ok {
+		input.ShareNotes = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Sharing Directory Service Directory: %s", input)
+	output, err := conn.ShareDirectory(input)
+
+	if err != nil {
+		return fmt.Errorf("error sharing Directory Service Directory (%s): %s", directoryId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", directoryId, aws.StringValue(output.SharedDirectoryId)))
+
+	return resourceAwsDirectoryServiceSharedDirectoryRead(d, meta)
+}
+
+func resourceAwsDirectoryServiceSharedDirectoryRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dsconn
+
+	ownerDirectoryId, sharedDirectoryId, err := parseDirectoryServiceSharedDirectoryId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	sharedDirectory, err := describeDirectoryServiceSharedDirectory(conn, ownerDirectoryId, sharedDirectoryId)
+
+	if isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") {
+		log.Printf("[WARN] Directory Service Shared Directory (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Directory Service Shared Directory (%s): %s", d.Id(), err)
+	}
+
+	if sharedDirectory == nil || aws.StringValue(sharedDirectory.ShareStatus) == directoryservice.ShareStatusDeleted {
+		log.Printf("[WARN] Directory Service Shared Directory (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("directory_id", sharedDirectory.OwnerDirectoryId)
+	d.Set("method", sharedDirectory.ShareMethod)
+	d.Set("notes", sharedDirectory.ShareNotes)
+	d.Set("shared_directory_id", sharedDirectory.SharedDirectoryId)
+
+	if err := d.Set("target", flattenDirectoryServiceShareTarget(sharedDirectory)); err != nil {
+		return fmt.Errorf("error setting target: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsDirectoryServiceSharedDirectoryDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dsconn
+
+	ownerDirectoryId, sharedDirectoryId, err := parseDirectoryServiceSharedDirectoryId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &directoryservice.UnshareDirectoryInput{
+		DirectoryId:   aws.String(ownerDirectoryId),
+		UnshareTarget: expandDirectoryServiceUnshareTarget(d.Get("target").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Unsharing Directory Service Directory: %s", input)
+	_, err = conn.UnshareDirectory(input)
+
+	if isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") || isAWSErr(err, directoryservice.ErrCodeDirectoryNotSharedException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error unsharing Directory Service Directory (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForDirectoryServiceSharedDirectoryDeletion(conn, ownerDirectoryId, sharedDirectoryId, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Directory Service Shared Directory (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func parseDirectoryServiceSharedDirectoryId(id string) (string, string, error) {
+	parts := strings.Split(id, "/")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected OWNER_DIRECTORY_ID/SHARED_DIRECTORY_ID", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func describeDirectoryServiceSharedDirectory(conn *directoryservice.DirectoryService, ownerDirectoryId, sharedDirectoryId string) (*directoryservice.SharedDirectory, error) {
+	input := &directoryservice.DescribeSharedDirectoriesInput{
+		OwnerDirectoryId:   aws.String(ownerDirectoryId),
+		SharedDirectoryIds: aws.StringSlice([]string{sharedDirectoryId}),
+	}
+
+	output, err := conn.DescribeSharedDirectories(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, sharedDirectory := range output.SharedDirectories {
+		if aws.StringValue(sharedDirectory.SharedDirectoryId) == sharedDirectoryId {
+			return sharedDirectory, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func waitForDirectoryServiceSharedDirectoryDeletion(conn *directoryservice.DirectoryService, ownerDirectoryId, sharedDirectoryId string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			directoryservice.ShareStatusDeleting,
+			directoryservice.ShareStatusPendingAcceptance,
+			directoryservice.ShareStatusRejectFailed,
+			directoryservice.ShareStatusRejected,
+			directoryservice.ShareStatusRejecting,
+			directoryservice.ShareStatusShareFailed,
+			directoryservice.ShareStatusShared,
+			directoryservice.ShareStatusSharing,
+		},
+		Target: []string{directoryservice.ShareStatusDeleted},
+		Refresh: func() (interface{}, string, error) {
+			sharedDirectory, err := describeDirectoryServiceSharedDirectory(conn, ownerDirectoryId, sharedDirectoryId)
+
+			if isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") {
+				return 42, directoryservice.ShareStatusDeleted, nil
+			}
+
+			if err != nil {
+				return nil, "", err
+			}
+
+			if sharedDirectory == nil {
+				return 42, directoryservice.ShareStatusDeleted, nil
+			}
+
+			return sharedDirectory, aws.StringValue(sharedDirectory.ShareStatus), nil
+		},
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func expandDirectoryServiceShareTarget(l []interface{}) *directoryservice.ShareTarget {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &directoryservice.ShareTarget{
+		Id:   aws.String(m["id"].(string)),
+		Type: aws.String(m["type"].(string)),
+	}
+}
+
+func expandDirectoryServiceUnshareTarget(l []interface{}) *directoryservice.UnshareTarget {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &directoryservice.UnshareTarget{
+		Id:   aws.String(m["id"].(string)),
+		Type: aws.String(m["type"].(string)),
+	}
+}
+
+// flattenDirectoryServiceShareTarget rebuilds the target block from the
+// consumer account, which is the only target type currently supported.
+func flattenDirectoryServiceShareTarget(sharedDirectory *directoryservice.SharedDirectory) []interface{} {
+	if sharedDirectory == nil || sharedDirectory.SharedAccountId == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"id":   aws.StringValue(sharedDirectory.SharedAccountId),
+		"type": directoryservice.TargetTypeAccount,
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,184 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDirectoryServiceSharedDirectoryAccepter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDirectoryServiceSharedDirectoryAccepterCreate,
+		Read:   resourceAwsDirectoryServiceSharedDirectoryAccepterRead,
+		Delete: resourceAwsDirectoryServiceSharedDirectoryAccepterDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"method": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"notes": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"owner_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"owner_directory_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"shared_directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDirectoryServiceSharedDirectoryAccepterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dsconn
+
+	sharedDirectoryId := d.Get("shared_directory_id").(string)
+	input := &directoryservice.AcceptSharedDirectoryInput{
+		SharedDirectoryId: aws.String(sharedDirectoryId),
+	}
+
+	log.Printf("[DEBUG] Accepting Directory Service Shared Directory: %s", input)
+	_, err := conn.AcceptSharedDirectory(input)
+
+	if err != nil {
+		return fmt.Errorf("error accepting Directory Service Shared Directory (%s): %s", sharedDirectoryId, err)
+	}
+
+	d.SetId(sharedDirectoryId)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			directoryservice.DirectoryStageRequested,
+			directoryservice.DirectoryStageCreating,
+			directoryservice.DirectoryStageCreated,
+		},
+		Target:  []string{directoryservice.DirectoryStageActive},
+		Refresh: directoryServiceDirectoryStageRefreshFunc(conn, d.Id()),
+		Timeout: d.Timeout(schema.TimeoutCreate),
+	}
+
+	log.Printf("[DEBUG] Waiting for Directory Service Shared Directory (%s) to become available", d.Id())
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Directory Service Shared Directory (%s) to become available: %s", d.Id(), err)
+	}
+
+	return resourceAwsDirectoryServiceSharedDirectoryAccepterRead(d, meta)
+}
+
+func resourceAwsDirectoryServiceSharedDirectoryAccepterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dsconn
+
+	output, err := conn.DescribeDirectories(&directoryservice.DescribeDirectoriesInput{
+		DirectoryIds: aws.StringSlice([]string{d.Id()}),
+	})
+
+	if isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") {
+		log.Printf("[WARN] Directory Service Shared Directory (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Directory Service Shared Directory (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || len(output.DirectoryDescriptions) == 0 || output.DirectoryDescriptions[0] == nil {
+		log.Printf("[WARN] Directory Service Shared Directory (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	dir := output.DirectoryDescriptions[0]
+
+	if aws.StringValue(dir.Stage) == directoryservice.DirectoryStageDeleted {
+		log.Printf("[WARN] Directory Service Shared Directory (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("method", dir.ShareMethod)
+	d.Set("notes", dir.ShareNotes)
+	d.Set("shared_directory_id", dir.DirectoryId)
+
+	if dir.OwnerDirectoryDescription != nil {
+		d.Set("owner_account_id", dir.OwnerDirectoryDescription.AccountId)
+		d.Set("owner_directory_id", dir.OwnerDirectoryDescription.DirectoryId)
+	}
+
+	return nil
+}
+
+func resourceAwsDirectoryServiceSharedDirectoryAccepterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dsconn
+
+	// Deleting the shared directory in the consumer account removes it from
+	// the account without affecting the owner's directory.
+	input := &directoryservice.DeleteDirectoryInput{
+		DirectoryId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Directory Service Shared Directory: %s", input)
+	_, err := conn.DeleteDirectory(input)
+
+	if isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Directory Service Shared Directory (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForDirectoryServiceDirectoryDeletion(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Directory Service Shared Directory (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func directoryServiceDirectoryStageRefreshFunc(conn *directoryservice.DirectoryService, directoryId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeDirectories(&directoryservice.DescribeDirectoriesInput{
+			DirectoryIds: aws.StringSlice([]string{directoryId}),
+		})
+
+		// The shared directory can take a moment to appear in the consumer account.
+		if isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil || len(output.DirectoryDescriptions) == 0 || output.DirectoryDescriptions[0] == nil {
+			return nil, "", nil
+		}
+
+		dir := output.DirectoryDescriptions[0]
+
+		return dir, aws.StringValue(dir.Stage), nil
+	}
+}
@@ -0,0 +1,175 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDirectoryServiceSharedDirectoryAccepter_basic(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_directory_service_shared_directory_accepter.test"
+	sharedDirectoryResourceName := "aws_directory_service_shared_directory.test"
+	domainName := fmt.Sprintf("tf-acc-%s.terraformtesting.com", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckAwsDirectoryServiceSharedDirectoryAccepterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDirectoryServiceSharedDirectoryAccepterConfig_basic(domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDirectoryServiceSharedDirectoryAccepterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "method", directoryservice.ShareMethodHandshake),
+					resource.TestCheckResourceAttr(resourceName, "notes", "Terraform testing"),
+					resource.TestCheckResourceAttrPair(resourceName, "owner_account_id", "data.aws_caller_identity.owner", "account_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "owner_directory_id", sharedDirectoryResourceName, "directory_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "shared_directory_id", sharedDirectoryResourceName, "shared_directory_id"),
+				),
+			},
+			{
+				Config:            testAccAwsDirectoryServiceSharedDirectoryAccepterConfig_basic(domainName),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDirectoryServiceSharedDirectoryAccepterDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).dsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_directory_service_shared_directory_accepter" {
+			continue
+		}
+
+		output, err := conn.DescribeDirectories(&directoryservice.DescribeDirectoriesInput{
+			DirectoryIds: aws.StringSlice([]string{rs.Primary.ID}),
+		})
+
+		if isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if len(output.DirectoryDescriptions) == 0 || aws.StringValue(output.DirectoryDescriptions[0].Stage) == directoryservice.DirectoryStageDeleted {
+			continue
+		}
+
+		return fmt.Errorf("Directory Service Shared Directory (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsDirectoryServiceSharedDirectoryAccepterExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Directory Service Shared Directory ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).dsconn
+
+		output, err := conn.DescribeDirectories(&directoryservice.DescribeDirectoriesInput{
+			DirectoryIds: aws.StringSlice([]string{rs.Primary.ID}),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if len(output.DirectoryDescriptions) == 0 {
+			return fmt.Errorf("Directory Service Shared Directory (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAwsDirectoryServiceSharedDirectoryAccepterConfig_basic(domainName string) string {
+	return testAccAlternateAccountProviderConfig() + fmt.Sprintf(`
+data "aws_availability_zones" "available" {
+  provider = "aws.alternate"
+
+  state = "available"
+}
+
+data "aws_caller_identity" "owner" {
+  provider = "aws.alternate"
+}
+
+data "aws_caller_identity" "consumer" {}
+
+resource "aws_vpc" "test" {
+  provider = "aws.alternate"
+
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = "terraform-testacc-directory-service-shared-directory-accepter"
+  }
+}
+
+resource "aws_subnet" "test" {
+  provider = "aws.alternate"
+  count    = 2
+
+  availability_zone = "${data.aws_availability_zones.available.names[count.index]}"
+  cidr_block        = "10.0.${count.index}.0/24"
+  vpc_id            = "${aws_vpc.test.id}"
+
+  tags = {
+    Name = "tf-acc-directory-service-shared-directory-accepter"
+  }
+}
+
+resource "aws_directory_service_directory" "test" {
+  provider = "aws.alternate"
+
+  edition  = "Standard"
+  name     = %[1]q
+  password = "SuperSecretPassw0rd"
+  type     = "MicrosoftAD"
+
+  vpc_settings {
+    subnet_ids = ["${aws_subnet.test.*.id}"]
+    vpc_id     = "${aws_vpc.test.id}"
+  }
+}
+
+resource "aws_directory_service_shared_directory" "test" {
+  provider = "aws.alternate"
+
+  directory_id = "${aws_directory_service_directory.test.id}"
+  notes        = "Terraform testing"
+
+  target {
+    id = "${data.aws_caller_identity.consumer.account_id}"
+  }
+}
+
+resource "aws_directory_service_shared_directory_accepter" "test" {
+  shared_directory_id = "${aws_directory_service_shared_directory.test.shared_directory_id}"
+}
+`, domainName)
+}
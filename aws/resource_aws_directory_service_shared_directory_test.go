@@ -0,0 +1,175 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDirectoryServiceSharedDirectory_basic(t *testing.T) {
+	var providers []*schema.Provider
+	var sharedDirectory directoryservice.SharedDirectory
+	resourceName := "aws_directory_service_shared_directory.test"
+	directoryResourceName := "aws_directory_service_directory.test"
+	domainName := fmt.Sprintf("tf-acc-%s.terraformtesting.com", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckAwsDirectoryServiceSharedDirectoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDirectoryServiceSharedDirectoryConfig_basic(domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDirectoryServiceSharedDirectoryExists(resourceName, &sharedDirectory),
+					resource.TestCheckResourceAttrPair(resourceName, "directory_id", directoryResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "method", directoryservice.ShareMethodHandshake),
+					resource.TestCheckResourceAttr(resourceName, "notes", "Terraform testing"),
+					resource.TestCheckResourceAttrSet(resourceName, "shared_directory_id"),
+					resource.TestCheckResourceAttr(resourceName, "target.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "target.0.id", "data.aws_caller_identity.consumer", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.type", directoryservice.TargetTypeAccount),
+				),
+			},
+			{
+				Config:            testAccAwsDirectoryServiceSharedDirectoryConfig_basic(domainName),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDirectoryServiceSharedDirectoryDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).dsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_directory_service_shared_directory" {
+			continue
+		}
+
+		ownerDirectoryId, sharedDirectoryId, err := parseDirectoryServiceSharedDirectoryId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		sharedDirectory, err := describeDirectoryServiceSharedDirectory(conn, ownerDirectoryId, sharedDirectoryId)
+
+		if isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if sharedDirectory == nil || aws.StringValue(sharedDirectory.ShareStatus) == directoryservice.ShareStatusDeleted {
+			continue
+		}
+
+		return fmt.Errorf("Directory Service Shared Directory (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsDirectoryServiceSharedDirectoryExists(resourceName string, sharedDirectory *directoryservice.SharedDirectory) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Directory Service Shared Directory ID is set")
+		}
+
+		ownerDirectoryId, sharedDirectoryId, err := parseDirectoryServiceSharedDirectoryId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).dsconn
+
+		output, err := describeDirectoryServiceSharedDirectory(conn, ownerDirectoryId, sharedDirectoryId)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("Directory Service Shared Directory (%s) not found", rs.Primary.ID)
+		}
+
+		*sharedDirectory = *output
+
+		return nil
+	}
+}
+
+func testAccAwsDirectoryServiceSharedDirectoryConfigBase(domainName string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {
+  state = "available"
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = "terraform-testacc-directory-service-shared-directory"
+  }
+}
+
+resource "aws_subnet" "test" {
+  count = 2
+
+  availability_zone = "${data.aws_availability_zones.available.names[count.index]}"
+  cidr_block        = "10.0.${count.index}.0/24"
+  vpc_id            = "${aws_vpc.test.id}"
+
+  tags = {
+    Name = "tf-acc-directory-service-shared-directory"
+  }
+}
+
+resource "aws_directory_service_directory" "test" {
+  edition  = "Standard"
+  name     = %[1]q
+  password = "SuperSecretPassw0rd"
+  type     = "MicrosoftAD"
+
+  vpc_settings {
+    subnet_ids = ["${aws_subnet.test.*.id}"]
+    vpc_id     = "${aws_vpc.test.id}"
+  }
+}
+`, domainName)
+}
+
+func testAccAwsDirectoryServiceSharedDirectoryConfig_basic(domainName string) string {
+	return testAccAlternateAccountProviderConfig() + testAccAwsDirectoryServiceSharedDirectoryConfigBase(domainName) + `
+data "aws_caller_identity" "consumer" {
+  provider = "aws.alternate"
+}
+
+resource "aws_directory_service_shared_directory" "test" {
+  directory_id = "${aws_directory_service_directory.test.id}"
+  notes        = "Terraform testing"
+
+  target {
+    id = "${data.aws_caller_identity.consumer.account_id}"
+  }
+}
+`
+}
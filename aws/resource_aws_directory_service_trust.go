@@ -0,0 +1,310 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsDirectoryServiceTrust() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDirectoryServiceTrustCreate,
+		Read:   resourceAwsDirectoryServiceTrustRead,
+		Update: resourceAwsDirectoryServiceTrustUpdate,
+		Delete: resourceAwsDirectoryServiceTrustDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"conditional_forwarder_ip_addrs": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.SingleIP(),
+				},
+				Set: schema.HashString,
+			},
+			"created_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delete_associated_conditional_forwarder": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"last_updated_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"remote_domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"selective_auth": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					directoryservice.SelectiveAuthDisabled,
+					directoryservice.SelectiveAuthEnabled,
+				}, false),
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"trust_direction": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					directoryservice.TrustDirectionOneWayIncoming,
+					directoryservice.TrustDirectionOneWayOutgoing,
+					directoryservice.TrustDirectionTwoWay,
+				}, false),
+			},
+			"trust_password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"trust_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  directoryservice.TrustTypeForest,
+				ValidateFunc: validation.StringInSlice([]string{
+					directoryservice.TrustTypeExternal,
+					directoryservice.TrustTypeForest,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsDirectoryServiceTrustCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dsconn
+
+	input := &directoryservice.CreateTrustInput{
+		DirectoryId:      aws.String(d.Get("directory_id").(string)),
+		RemoteDomainName: aws.String(d.Get("remote_domain_name").(string)),
+		TrustDirection:   aws.String(d.Get("trust_direction").(string)),
+		TrustPassword:    aws.String(d.Get("trust_password").(string)),
+		TrustType:        aws.String(d.Get("trust_type").(string)),
+	}
+
+	if v, ok := d.GetOk("conditional_forwarder_ip_addrs"); ok && v.(*schema.Set).Len() > 0 {
+		input.ConditionalForwarderIpAddrs = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("selective_auth"); ok {
+		input.SelectiveAuth = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Directory Service Trust: %s", input)
+	output, err := conn.CreateTrust(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Directory Service Trust: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.TrustId))
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			directoryservice.TrustStateCreating,
+			directoryservice.TrustStateCreated,
+			directoryservice.TrustStateVerifying,
+		},
+		Target: []string{
+			directoryservice.TrustStateVerified,
+			directoryservice.TrustStateVerifyFailed,
+		},
+		Refresh: directoryServiceTrustStateRefreshFunc(conn, d.Id()),
+		Timeout: d.Timeout(schema.TimeoutCreate),
+	}
+
+	log.Printf("[DEBUG] Waiting for Directory Service Trust (%s) creation", d.Id())
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Directory Service Trust (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsDirectoryServiceTrustRead(d, meta)
+}
+
+func resourceAwsDirectoryServiceTrustRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dsconn
+
+	trust, err := describeDirectoryServiceTrust(conn, d.Id())
+
+	if isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") {
+		log.Printf("[WARN] Directory Service Trust (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Directory Service Trust (%s): %s", d.Id(), err)
+	}
+
+	if trust == nil || aws.StringValue(trust.TrustState) == directoryservice.TrustStateDeleted {
+		log.Printf("[WARN] Directory Service Trust (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("created_date_time", aws.TimeValue(trust.CreatedDateTime).Format(time.RFC3339))
+	d.Set("directory_id", trust.DirectoryId)
+	d.Set("last_updated_date_time", aws.TimeValue(trust.LastUpdatedDateTime).Format(time.RFC3339))
+	d.Set("remote_domain_name", trust.RemoteDomainName)
+	d.Set("selective_auth", trust.SelectiveAuth)
+	d.Set("state", trust.TrustState)
+	d.Set("state_reason", trust.TrustStateReason)
+	d.Set("trust_direction", trust.TrustDirection)
+	d.Set("trust_type", trust.TrustType)
+
+	return nil
+}
+
+func resourceAwsDirectoryServiceTrustUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dsconn
+
+	if d.HasChange("selective_auth") {
+		input := &directoryservice.UpdateTrustInput{
+			SelectiveAuth: aws.String(d.Get("selective_auth").(string)),
+			TrustId:       aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating Directory Service Trust: %s", input)
+		if _, err := conn.UpdateTrust(input); err != nil {
+			return fmt.Errorf("error updating Directory Service Trust (%s): %s", d.Id(), err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending: []string{
+				directoryservice.TrustStateUpdating,
+				directoryservice.TrustStateVerifying,
+			},
+			Target: []string{
+				directoryservice.TrustStateUpdated,
+				directoryservice.TrustStateVerified,
+				directoryservice.TrustStateVerifyFailed,
+			},
+			Refresh: directoryServiceTrustStateRefreshFunc(conn, d.Id()),
+			Timeout: d.Timeout(schema.TimeoutUpdate),
+		}
+
+		log.Printf("[DEBUG] Waiting for Directory Service Trust (%s) update", d.Id())
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("error waiting for Directory Service Trust (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsDirectoryServiceTrustRead(d, meta)
+}
+
+func resourceAwsDirectoryServiceTrustDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dsconn
+
+	input := &directoryservice.DeleteTrustInput{
+		DeleteAssociatedConditionalForwarder: aws.Bool(d.Get("delete_associated_conditional_forwarder").(bool)),
+		TrustId:                              aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Directory Service Trust: %s", input)
+	_, err := conn.DeleteTrust(input)
+
+	if isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Directory Service Trust (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{directoryservice.TrustStateDeleting},
+		Target:  []string{directoryservice.TrustStateDeleted},
+		Refresh: directoryServiceTrustStateRefreshFunc(conn, d.Id()),
+		Timeout: d.Timeout(schema.TimeoutDelete),
+	}
+
+	log.Printf("[DEBUG] Waiting for Directory Service Trust (%s) deletion", d.Id())
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Directory Service Trust (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func describeDirectoryServiceTrust(conn *directoryservice.DirectoryService, trustId string) (*directoryservice.Trust, error) {
+	input := &directoryservice.DescribeTrustsInput{
+		TrustIds: aws.StringSlice([]string{trustId}),
+	}
+
+	output, err := conn.DescribeTrusts(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, trust := range output.Trusts {
+		if aws.StringValue(trust.TrustId) == trustId {
+			return trust, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func directoryServiceTrustStateRefreshFunc(conn *directoryservice.DirectoryService, trustId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		trust, err := describeDirectoryServiceTrust(conn, trustId)
+
+		if isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") {
+			return 42, directoryservice.TrustStateDeleted, nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if trust == nil {
+			return 42, directoryservice.TrustStateDeleted, nil
+		}
+
+		if state := aws.StringValue(trust.TrustState); state == directoryservice.TrustStateFailed || state == directoryservice.TrustStateUpdateFailed {
+			return trust, state, fmt.Errorf("%s: %s", state, aws.StringValue(trust.TrustStateReason))
+		}
+
+		return trust, aws.StringValue(trust.TrustState), nil
+	}
+}
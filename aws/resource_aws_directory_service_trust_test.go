@@ -0,0 +1,175 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDirectoryServiceTrust_basic(t *testing.T) {
+	var trust directoryservice.Trust
+	resourceName := "aws_directory_service_trust.test"
+	directoryResourceName := "aws_directory_service_directory.test"
+	domainName := fmt.Sprintf("tf-acc-%s.terraformtesting.com", acctest.RandString(8))
+	remoteDomainName := fmt.Sprintf("tf-acc-%s.terraformtesting.com", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDirectoryServiceTrustDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDirectoryServiceTrustConfig_basic(domainName, remoteDomainName, directoryservice.SelectiveAuthDisabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDirectoryServiceTrustExists(resourceName, &trust),
+					resource.TestCheckResourceAttr(resourceName, "conditional_forwarder_ip_addrs.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_date_time"),
+					resource.TestCheckResourceAttrPair(resourceName, "directory_id", directoryResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "remote_domain_name", remoteDomainName),
+					resource.TestCheckResourceAttr(resourceName, "selective_auth", directoryservice.SelectiveAuthDisabled),
+					resource.TestCheckResourceAttrSet(resourceName, "state"),
+					resource.TestCheckResourceAttr(resourceName, "trust_direction", directoryservice.TrustDirectionOneWayOutgoing),
+					resource.TestCheckResourceAttr(resourceName, "trust_type", directoryservice.TrustTypeForest),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"conditional_forwarder_ip_addrs", "delete_associated_conditional_forwarder", "trust_password"},
+			},
+			{
+				Config: testAccAwsDirectoryServiceTrustConfig_basic(domainName, remoteDomainName, directoryservice.SelectiveAuthEnabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDirectoryServiceTrustExists(resourceName, &trust),
+					resource.TestCheckResourceAttr(resourceName, "selective_auth", directoryservice.SelectiveAuthEnabled),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDirectoryServiceTrustDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).dsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_directory_service_trust" {
+			continue
+		}
+
+		trust, err := describeDirectoryServiceTrust(conn, rs.Primary.ID)
+
+		if isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if trust == nil {
+			continue
+		}
+
+		return fmt.Errorf("Directory Service Trust (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsDirectoryServiceTrustExists(resourceName string, trust *directoryservice.Trust) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Directory Service Trust ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).dsconn
+
+		output, err := describeDirectoryServiceTrust(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("Directory Service Trust (%s) not found", rs.Primary.ID)
+		}
+
+		*trust = *output
+
+		return nil
+	}
+}
+
+func testAccAwsDirectoryServiceTrustConfig_basic(domainName, remoteDomainName, selectiveAuth string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {
+  state = "available"
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = "terraform-testacc-directory-service-trust"
+  }
+}
+
+resource "aws_subnet" "test" {
+  count = 2
+
+  availability_zone = "${data.aws_availability_zones.available.names[count.index]}"
+  cidr_block        = "10.0.${count.index}.0/24"
+  vpc_id            = "${aws_vpc.test.id}"
+
+  tags = {
+    Name = "tf-acc-directory-service-trust"
+  }
+}
+
+resource "aws_directory_service_directory" "test" {
+  edition  = "Standard"
+  name     = %[1]q
+  password = "SuperSecretPassw0rd"
+  type     = "MicrosoftAD"
+
+  vpc_settings {
+    subnet_ids = ["${aws_subnet.test.*.id}"]
+    vpc_id     = "${aws_vpc.test.id}"
+  }
+}
+
+resource "aws_directory_service_directory" "remote" {
+  edition  = "Standard"
+  name     = %[2]q
+  password = "SuperSecretPassw0rd"
+  type     = "MicrosoftAD"
+
+  vpc_settings {
+    subnet_ids = ["${aws_subnet.test.*.id}"]
+    vpc_id     = "${aws_vpc.test.id}"
+  }
+}
+
+resource "aws_directory_service_trust" "test" {
+  directory_id = "${aws_directory_service_directory.test.id}"
+
+  conditional_forwarder_ip_addrs = ["${aws_directory_service_directory.remote.dns_ip_addresses}"]
+  remote_domain_name             = "${aws_directory_service_directory.remote.name}"
+  selective_auth                 = %[3]q
+  trust_direction                = "One-Way: Outgoing"
+  trust_password                 = "Some0therPassword"
+
+  delete_associated_conditional_forwarder = true
+}
+`, domainName, remoteDomainName, selectiveAuth)
+}
@@ -21,15 +21,28 @@ func resourceAwsDmsReplicationTask() *schema.Resource {
 		Update: resourceAwsDmsReplicationTaskUpdate,
 		Delete: resourceAwsDmsReplicationTaskDelete,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
 		Schema: map[string]*schema.Schema{
+			"cdc_start_position": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"cdc_start_time"},
+			},
 			"cdc_start_time": {
 				Type:     schema.TypeString,
 				Optional: true,
 				// Requires a Unix timestamp in seconds. Example 1484346880
+				ConflictsWith: []string{"cdc_start_position"},
 			},
 			"migration_type": {
 				Type:     schema.TypeString,
@@ -62,6 +75,15 @@ func resourceAwsDmsReplicationTask() *schema.Resource {
 				ValidateFunc:     validation.ValidateJsonString,
 				DiffSuppressFunc: suppressEquivalentJsonDiffs,
 			},
+			"start_replication_task": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"source_endpoint_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -101,6 +123,10 @@ func resourceAwsDmsReplicationTaskCreate(d *schema.ResourceData, meta interface{
 		TargetEndpointArn:         aws.String(d.Get("target_endpoint_arn").(string)),
 	}
 
+	if v, ok := d.GetOk("cdc_start_position"); ok {
+		request.CdcStartPosition = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("cdc_start_time"); ok {
 		seconds, err := strconv.ParseInt(v.(string), 10, 64)
 		if err != nil {
@@ -138,6 +164,12 @@ func resourceAwsDmsReplicationTaskCreate(d *schema.ResourceData, meta interface{
 		return err
 	}
 
+	if d.Get("start_replication_task").(bool) {
+		if err := resourceAwsDmsReplicationTaskStart(d, meta, dms.StartReplicationTaskTypeValueStartReplication, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
 	return resourceAwsDmsReplicationTaskRead(d, meta)
 }
 
@@ -185,6 +217,11 @@ func resourceAwsDmsReplicationTaskUpdate(d *schema.ResourceData, meta interface{
 	}
 	hasChanges := false
 
+	if d.HasChange("cdc_start_position") {
+		request.CdcStartPosition = aws.String(d.Get("cdc_start_position").(string))
+		hasChanges = true
+	}
+
 	if d.HasChange("cdc_start_time") {
 		seconds, err := strconv.ParseInt(d.Get("cdc_start_time").(string), 10, 64)
 		if err != nil {
@@ -217,6 +254,13 @@ func resourceAwsDmsReplicationTaskUpdate(d *schema.ResourceData, meta interface{
 	}
 
 	if hasChanges {
+		// A running task must be stopped before it can be modified.
+		if d.Get("status").(string) == "running" {
+			if err := resourceAwsDmsReplicationTaskStop(d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+
 		log.Println("[DEBUG] DMS update replication task:", request)
 
 		_, err := conn.ModifyReplicationTask(request)
@@ -228,7 +272,7 @@ func resourceAwsDmsReplicationTaskUpdate(d *schema.ResourceData, meta interface{
 			Pending:    []string{"modifying"},
 			Target:     []string{"ready", "stopped", "failed"},
 			Refresh:    resourceAwsDmsReplicationTaskStateRefreshFunc(d, meta),
-			Timeout:    d.Timeout(schema.TimeoutCreate),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
 			MinTimeout: 10 * time.Second,
 			Delay:      30 * time.Second, // Wait 30 secs before starting
 		}
@@ -238,16 +282,58 @@ func resourceAwsDmsReplicationTaskUpdate(d *schema.ResourceData, meta interface{
 		if err != nil {
 			return err
 		}
+	}
+
+	if err := resourceAwsDmsReplicationTaskUpdateRunState(d, meta, hasChanges); err != nil {
+		return err
+	}
+
+	return resourceAwsDmsReplicationTaskRead(d, meta)
+}
+
+// resourceAwsDmsReplicationTaskUpdateRunState starts or stops the task to
+// match start_replication_task, e.g. after it was stopped to be modified.
+func resourceAwsDmsReplicationTaskUpdateRunState(d *schema.ResourceData, meta interface{}, modified bool) error {
+	status := d.Get("status").(string)
+	start := d.Get("start_replication_task").(bool)
+
+	if !start {
+		if d.HasChange("start_replication_task") && status == "running" {
+			return resourceAwsDmsReplicationTaskStop(d, meta, d.Timeout(schema.TimeoutUpdate))
+		}
 
-		return resourceAwsDmsReplicationTaskRead(d, meta)
+		return nil
 	}
 
-	return nil
+	if !modified && !d.HasChange("start_replication_task") {
+		return nil
+	}
+
+	// The task may already have been started outside of Terraform, in which
+	// case starting it again fails with InvalidResourceStateFault.
+	if !modified && status == "running" {
+		return nil
+	}
+
+	// A task that has never been started has to be started from the
+	// beginning, otherwise it resumes from where it was stopped.
+	startType := dms.StartReplicationTaskTypeValueResumeProcessing
+	if status == "ready" {
+		startType = dms.StartReplicationTaskTypeValueStartReplication
+	}
+
+	return resourceAwsDmsReplicationTaskStart(d, meta, startType, d.Timeout(schema.TimeoutUpdate))
 }
 
 func resourceAwsDmsReplicationTaskDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).dmsconn
 
+	if d.Get("status").(string) == "running" {
+		if err := resourceAwsDmsReplicationTaskStop(d, meta, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return err
+		}
+	}
+
 	request := &dms.DeleteReplicationTaskInput{
 		ReplicationTaskArn: aws.String(d.Get("replication_task_arn").(string)),
 	}
@@ -267,7 +353,7 @@ func resourceAwsDmsReplicationTaskDelete(d *schema.ResourceData, meta interface{
 		Pending:    []string{"deleting"},
 		Target:     []string{},
 		Refresh:    resourceAwsDmsReplicationTaskStateRefreshFunc(d, meta),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
 		MinTimeout: 10 * time.Second,
 		Delay:      30 * time.Second, // Wait 30 secs before starting
 	}
@@ -281,18 +367,88 @@ func resourceAwsDmsReplicationTaskDelete(d *schema.ResourceData, meta interface{
 func resourceAwsDmsReplicationTaskSetState(d *schema.ResourceData, task *dms.ReplicationTask) error {
 	d.SetId(*task.ReplicationTaskIdentifier)
 
+	d.Set("cdc_start_position", task.CdcStartPosition)
 	d.Set("migration_type", task.MigrationType)
 	d.Set("replication_instance_arn", task.ReplicationInstanceArn)
 	d.Set("replication_task_arn", task.ReplicationTaskArn)
 	d.Set("replication_task_id", task.ReplicationTaskIdentifier)
 	d.Set("replication_task_settings", task.ReplicationTaskSettings)
 	d.Set("source_endpoint_arn", task.SourceEndpointArn)
+	d.Set("status", task.Status)
 	d.Set("table_mappings", task.TableMappings)
 	d.Set("target_endpoint_arn", task.TargetEndpointArn)
 
 	return nil
 }
 
+func resourceAwsDmsReplicationTaskStart(d *schema.ResourceData, meta interface{}, startType string, timeout time.Duration) error {
+	conn := meta.(*AWSClient).dmsconn
+
+	request := &dms.StartReplicationTaskInput{
+		ReplicationTaskArn:       aws.String(d.Get("replication_task_arn").(string)),
+		StartReplicationTaskType: aws.String(startType),
+	}
+
+	log.Println("[DEBUG] DMS start replication task:", request)
+
+	_, err := conn.StartReplicationTask(request)
+	if err != nil {
+		return fmt.Errorf("error starting DMS Replication Task (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"starting"},
+		// Full load only tasks stop by themselves once the load completes.
+		Target:     []string{"running", "stopped"},
+		Refresh:    resourceAwsDmsReplicationTaskStateRefreshFunc(d, meta),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second, // Wait 30 secs before starting
+	}
+
+	// Wait, catching any errors
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("error waiting for DMS Replication Task (%s) to start: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsDmsReplicationTaskStop(d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	conn := meta.(*AWSClient).dmsconn
+
+	request := &dms.StopReplicationTaskInput{
+		ReplicationTaskArn: aws.String(d.Get("replication_task_arn").(string)),
+	}
+
+	log.Println("[DEBUG] DMS stop replication task:", request)
+
+	_, err := conn.StopReplicationTask(request)
+	if err != nil {
+		return fmt.Errorf("error stopping DMS Replication Task (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"running", "stopping"},
+		Target:     []string{"stopped"},
+		Refresh:    resourceAwsDmsReplicationTaskStateRefreshFunc(d, meta),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second, // Wait 30 secs before starting
+	}
+
+	// Wait, catching any errors
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("error waiting for DMS Replication Task (%s) to stop: %s", d.Id(), err)
+	}
+
+	d.Set("status", "stopped")
+
+	return nil
+}
+
 func resourceAwsDmsReplicationTaskStateRefreshFunc(
 	d *schema.ResourceData, meta interface{}) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
@@ -25,12 +26,15 @@ func TestAccAWSDmsReplicationTaskBasic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					checkDmsReplicationTaskExists(resourceName),
 					resource.TestCheckResourceAttrSet(resourceName, "replication_task_arn"),
+					resource.TestCheckResourceAttr(resourceName, "start_replication_task", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ready"),
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"start_replication_task"},
 			},
 			{
 				Config: dmsReplicationTaskConfigUpdate(randId),
@@ -42,6 +46,103 @@ func TestAccAWSDmsReplicationTaskBasic(t *testing.T) {
 	})
 }
 
+func TestAccAWSDmsReplicationTask_StartReplicationTask(t *testing.T) {
+	resourceName := "aws_dms_replication_task.dms_replication_task"
+	randId := acctest.RandString(8)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: dmsReplicationTaskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: dmsReplicationTaskConfigStartReplicationTask(randId, true),
+				Check: resource.ComposeTestCheckFunc(
+					checkDmsReplicationTaskExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "start_replication_task", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", "running"),
+				),
+			},
+			{
+				Config: dmsReplicationTaskConfigStartReplicationTask(randId, false),
+				Check: resource.ComposeTestCheckFunc(
+					checkDmsReplicationTaskExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "start_replication_task", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status", "stopped"),
+				),
+			},
+			// A task started outside of Terraform must not be started again
+			{
+				PreConfig: func() {
+					if err := testAccAWSDmsReplicationTaskStart(fmt.Sprintf("tf-test-dms-replication-task-%s", randId)); err != nil {
+						t.Fatalf("error starting DMS replication task: %s", err)
+					}
+				},
+				Config: dmsReplicationTaskConfigStartReplicationTask(randId, true),
+				Check: resource.ComposeTestCheckFunc(
+					checkDmsReplicationTaskExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "start_replication_task", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", "running"),
+				),
+			},
+		},
+	})
+}
+
+// testAccAWSDmsReplicationTaskStart resumes a stopped replication task and
+// waits for it to run, e.g. to simulate a task started outside of Terraform.
+func testAccAWSDmsReplicationTaskStart(id string) error {
+	conn := testAccProvider.Meta().(*AWSClient).dmsconn
+
+	refresh := func() (interface{}, string, error) {
+		resp, err := conn.DescribeReplicationTasks(&dms.DescribeReplicationTasksInput{
+			Filters: []*dms.Filter{
+				{
+					Name:   aws.String("replication-task-id"),
+					Values: []*string{aws.String(id)},
+				},
+			},
+		})
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if len(resp.ReplicationTasks) == 0 {
+			return nil, "", fmt.Errorf("DMS replication task (%s) not found", id)
+		}
+
+		task := resp.ReplicationTasks[0]
+		return task, aws.StringValue(task.Status), nil
+	}
+
+	task, _, err := refresh()
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.StartReplicationTask(&dms.StartReplicationTaskInput{
+		ReplicationTaskArn:       task.(*dms.ReplicationTask).ReplicationTaskArn,
+		StartReplicationTaskType: aws.String(dms.StartReplicationTaskTypeValueResumeProcessing),
+	})
+	if err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"starting"},
+		Target:     []string{"running"},
+		Refresh:    refresh,
+		Timeout:    20 * time.Minute,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+
+	return err
+}
+
 func checkDmsReplicationTaskExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -269,3 +370,184 @@ resource "aws_dms_replication_task" "dms_replication_task" {
 }
 `, randId)
 }
+
+// The task is an S3 to S3 migration reached through a gateway VPC endpoint so
+// that it can actually be started from the private replication instance.
+func dmsReplicationTaskConfigStartReplicationTask(randId string, startReplicationTask bool) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {
+	state = "available"
+}
+
+data "aws_region" "current" {}
+
+resource "aws_vpc" "dms_vpc" {
+	cidr_block = "10.1.0.0/16"
+	tags = {
+		Name = "terraform-testacc-dms-replication-task-start"
+	}
+}
+
+resource "aws_subnet" "dms_subnet_1" {
+	cidr_block = "10.1.1.0/24"
+	availability_zone = "${data.aws_availability_zones.available.names[0]}"
+	vpc_id = "${aws_vpc.dms_vpc.id}"
+	tags = {
+		Name = "tf-acc-dms-replication-task-start-1"
+	}
+}
+
+resource "aws_subnet" "dms_subnet_2" {
+	cidr_block = "10.1.2.0/24"
+	availability_zone = "${data.aws_availability_zones.available.names[1]}"
+	vpc_id = "${aws_vpc.dms_vpc.id}"
+	tags = {
+		Name = "tf-acc-dms-replication-task-start-2"
+	}
+}
+
+resource "aws_vpc_endpoint" "s3" {
+	vpc_id = "${aws_vpc.dms_vpc.id}"
+	service_name = "com.amazonaws.${data.aws_region.current.name}.s3"
+	route_table_ids = ["${aws_vpc.dms_vpc.main_route_table_id}"]
+}
+
+resource "aws_s3_bucket" "dms_bucket" {
+	bucket = "tf-test-dms-replication-task-%[1]s"
+	force_destroy = true
+}
+
+resource "aws_s3_bucket_object" "dms_source_data" {
+	bucket = "${aws_s3_bucket.dms_bucket.id}"
+	key = "source/tftest/tftest/LOAD00000001.csv"
+	content = "1,one\n2,two\n"
+}
+
+resource "aws_iam_role" "iam_role" {
+	name = "tf-test-iam-s3-role-%[1]s"
+
+	assume_role_policy = <<EOF
+{
+	"Version": "2012-10-17",
+	"Statement": [
+		{
+			"Action": "sts:AssumeRole",
+			"Principal": {
+				"Service": "dms.amazonaws.com"
+			},
+			"Effect": "Allow"
+		}
+	]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "dms_s3_access" {
+	name = "tf-test-iam-s3-role-policy-%[1]s"
+	role = "${aws_iam_role.iam_role.name}"
+
+	policy = <<EOF
+{
+	"Version": "2012-10-17",
+	"Statement": [
+		{
+			"Effect": "Allow",
+			"Action": [
+				"s3:ListBucket",
+				"s3:GetObject",
+				"s3:PutObject",
+				"s3:DeleteObject"
+			],
+			"Resource": [
+				"${aws_s3_bucket.dms_bucket.arn}",
+				"${aws_s3_bucket.dms_bucket.arn}/*"
+			]
+		}
+	]
+}
+EOF
+}
+
+resource "aws_dms_endpoint" "dms_endpoint_source" {
+	endpoint_id = "tf-test-dms-endpoint-source-%[1]s"
+	endpoint_type = "source"
+	engine_name = "s3"
+	extra_connection_attributes = "cdcPath=cdc"
+
+	s3_settings {
+		service_access_role_arn = "${aws_iam_role.iam_role.arn}"
+		bucket_name = "${aws_s3_bucket.dms_bucket.id}"
+		bucket_folder = "source"
+		external_table_definition = <<EOF
+{
+	"TableCount": "1",
+	"Tables": [
+		{
+			"TableName": "tftest",
+			"TablePath": "tftest/tftest/",
+			"TableOwner": "tftest",
+			"TableColumns": [
+				{
+					"ColumnName": "id",
+					"ColumnType": "INT8",
+					"ColumnNullable": "false",
+					"ColumnIsPk": "true"
+				},
+				{
+					"ColumnName": "name",
+					"ColumnType": "STRING",
+					"ColumnLength": "20"
+				}
+			],
+			"TableColumnsTotal": "2"
+		}
+	]
+}
+EOF
+	}
+
+	depends_on = ["aws_iam_role_policy.dms_s3_access"]
+}
+
+resource "aws_dms_endpoint" "dms_endpoint_target" {
+	endpoint_id = "tf-test-dms-endpoint-target-%[1]s"
+	endpoint_type = "target"
+	engine_name = "s3"
+
+	s3_settings {
+		service_access_role_arn = "${aws_iam_role.iam_role.arn}"
+		bucket_name = "${aws_s3_bucket.dms_bucket.id}"
+		bucket_folder = "target"
+	}
+
+	depends_on = ["aws_iam_role_policy.dms_s3_access"]
+}
+
+resource "aws_dms_replication_subnet_group" "dms_replication_subnet_group" {
+	replication_subnet_group_id = "tf-test-dms-replication-subnet-group-%[1]s"
+	replication_subnet_group_description = "terraform test for replication subnet group"
+	subnet_ids = ["${aws_subnet.dms_subnet_1.id}", "${aws_subnet.dms_subnet_2.id}"]
+}
+
+resource "aws_dms_replication_instance" "dms_replication_instance" {
+	allocated_storage = 5
+	auto_minor_version_upgrade = true
+	replication_instance_class = "dms.t2.micro"
+	replication_instance_id = "tf-test-dms-replication-instance-%[1]s"
+	publicly_accessible = false
+	replication_subnet_group_id = "${aws_dms_replication_subnet_group.dms_replication_subnet_group.replication_subnet_group_id}"
+
+	depends_on = ["aws_vpc_endpoint.s3"]
+}
+
+resource "aws_dms_replication_task" "dms_replication_task" {
+	migration_type = "full-load-and-cdc"
+	replication_instance_arn = "${aws_dms_replication_instance.dms_replication_instance.replication_instance_arn}"
+	replication_task_id = "tf-test-dms-replication-task-%[1]s"
+	source_endpoint_arn = "${aws_dms_endpoint.dms_endpoint_source.endpoint_arn}"
+	start_replication_task = %[2]t
+	table_mappings = "{\"rules\":[{\"rule-type\":\"selection\",\"rule-id\":\"1\",\"rule-name\":\"1\",\"object-locator\":{\"schema-name\":\"%%\",\"table-name\":\"%%\"},\"rule-action\":\"include\"}]}"
+	target_endpoint_arn = "${aws_dms_endpoint.dms_endpoint_target.endpoint_arn}"
+}
+`, randId, startReplicationTask)
+}
@@ -14,6 +14,20 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 )
 
+// docDBClusterIAMRoleEventualConsistencyError is returned until the cluster IAM roles propagate.
+var docDBClusterIAMRoleEventualConsistencyError = awsEventualConsistencyError{
+	code:    "InvalidParameterValue",
+	message: "IAM role ARN value is invalid or does not include the required permissions",
+}
+
+// docDBClusterModifyEventualConsistencyErrors are returned by ModifyDBCluster until the cluster
+// IAM roles propagate and the cluster is available for modification.
+var docDBClusterModifyEventualConsistencyErrors = []awsEventualConsistencyError{
+	docDBClusterIAMRoleEventualConsistencyError,
+	{code: docdb.ErrCodeInvalidDBClusterStateFault, message: "is not currently in the available state"},
+	{code: docdb.ErrCodeInvalidDBClusterStateFault, message: "DB cluster is not available for modification"},
+}
+
 func resourceAwsDocDBCluster() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsDocDBClusterCreate,
@@ -330,16 +344,9 @@ func resourceAwsDocDBClusterCreate(d *schema.ResourceData, meta interface{}) err
 		}
 
 		log.Printf("[DEBUG] DocDB Cluster restore from snapshot configuration: %s", opts)
-		err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-			_, err := conn.RestoreDBClusterFromSnapshot(&opts)
-			if err != nil {
-				if isAWSErr(err, "InvalidParameterValue", "IAM role ARN value is invalid or does not include the required permissions") {
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
+		_, err := retryOnAwsEventualConsistencyErrors(meta.(*AWSClient).eventualConsistencyTimeout, func() (interface{}, error) {
+			return conn.RestoreDBClusterFromSnapshot(&opts)
+		}, docDBClusterIAMRoleEventualConsistencyError)
 		if err != nil {
 			return fmt.Errorf("Error creating DocDB Cluster: %s", err)
 		}
@@ -409,18 +416,9 @@ func resourceAwsDocDBClusterCreate(d *schema.ResourceData, meta interface{}) err
 		}
 
 		log.Printf("[DEBUG] DocDB Cluster create options: %s", createOpts)
-		var resp *docdb.CreateDBClusterOutput
-		err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-			var err error
-			resp, err = conn.CreateDBCluster(createOpts)
-			if err != nil {
-				if isAWSErr(err, "InvalidParameterValue", "IAM role ARN value is invalid or does not include the required permissions") {
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
+		resp, err := retryOnAwsEventualConsistencyErrors(meta.(*AWSClient).eventualConsistencyTimeout, func() (interface{}, error) {
+			return conn.CreateDBCluster(createOpts)
+		}, docDBClusterIAMRoleEventualConsistencyError)
 		if err != nil {
 			return fmt.Errorf("error creating DocDB cluster: %s", err)
 		}
@@ -617,25 +615,16 @@ func resourceAwsDocDBClusterUpdate(d *schema.ResourceData, meta interface{}) err
 	}
 
 	if requestUpdate {
-		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-			_, err := conn.ModifyDBCluster(req)
-			if err != nil {
-				if isAWSErr(err, "InvalidParameterValue", "IAM role ARN value is invalid or does not include the required permissions") {
-					return resource.RetryableError(err)
-				}
-
-				if isAWSErr(err, docdb.ErrCodeInvalidDBClusterStateFault, "is not currently in the available state") {
-					return resource.RetryableError(err)
-				}
-
-				if isAWSErr(err, docdb.ErrCodeInvalidDBClusterStateFault, "DB cluster is not available for modification") {
-					return resource.RetryableError(err)
-				}
-
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
+		// The cluster may also still be settling from a previous modification,
+		// so wait at least as long as before for it to become available.
+		timeout := 5 * time.Minute
+		if v := meta.(*AWSClient).eventualConsistencyTimeout; v > timeout {
+			timeout = v
+		}
+
+		_, err := retryOnAwsEventualConsistencyErrors(timeout, func() (interface{}, error) {
+			return conn.ModifyDBCluster(req)
+		}, docDBClusterModifyEventualConsistencyErrors...)
 		if err != nil {
 			return fmt.Errorf("Failed to modify DocDB Cluster (%s): %s", d.Id(), err)
 		}
@@ -21,6 +21,17 @@ import (
 
 var taskDefinitionRE = regexp.MustCompile("^([a-zA-Z0-9_-]+):([0-9]+)$")
 
+// ecsServiceEventualConsistencyErrors are returned until the service role and its policies, or the
+// target group's association with its load balancer, propagate.
+var ecsServiceEventualConsistencyErrors = []awsEventualConsistencyError{
+	{code: ecs.ErrCodeInvalidParameterException, message: "Please verify that the ECS service role being passed has the proper permissions."},
+	{code: ecs.ErrCodeInvalidParameterException, message: "does not have an associated load balancer"},
+}
+
+// ecsServiceCreateEventualConsistencyErrors are ecsServiceEventualConsistencyErrors plus the
+// error returned until a newly created cluster propagates.
+var ecsServiceCreateEventualConsistencyErrors = append([]awsEventualConsistencyError{{code: ecs.ErrCodeClusterNotFoundException}}, ecsServiceEventualConsistencyErrors...)
+
 func resourceAwsEcsService() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsEcsServiceCreate,
@@ -478,30 +489,15 @@ func resourceAwsEcsServiceCreate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Creating ECS service: %s", input)
 
 	// Retry due to AWS IAM & ECS eventual consistency
-	var out *ecs.CreateServiceOutput
-	var err error
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
-		out, err = conn.CreateService(&input)
-
-		if err != nil {
-			if isAWSErr(err, ecs.ErrCodeClusterNotFoundException, "") {
-				return resource.RetryableError(err)
-			}
-			if isAWSErr(err, ecs.ErrCodeInvalidParameterException, "Please verify that the ECS service role being passed has the proper permissions.") {
-				return resource.RetryableError(err)
-			}
-			if isAWSErr(err, ecs.ErrCodeInvalidParameterException, "does not have an associated load balancer") {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
-	})
+	output, err := retryOnAwsEventualConsistencyErrors(meta.(*AWSClient).eventualConsistencyTimeout, func() (interface{}, error) {
+		return conn.CreateService(&input)
+	}, ecsServiceCreateEventualConsistencyErrors...)
 	if err != nil {
 		return fmt.Errorf("%s %q", err, d.Get("name").(string))
 	}
 
+	out := output.(*ecs.CreateServiceOutput)
+
 	service := *out.Service
 
 	log.Printf("[DEBUG] ECS service created: %s", *service.ServiceArn)
@@ -851,24 +847,14 @@ func resourceAwsEcsServiceUpdate(d *schema.ResourceData, meta interface{}) error
 	if updateService {
 		log.Printf("[DEBUG] Updating ECS Service (%s): %s", d.Id(), input)
 		// Retry due to IAM eventual consistency
-		err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-			out, err := conn.UpdateService(&input)
-			if err != nil {
-				if isAWSErr(err, ecs.ErrCodeInvalidParameterException, "Please verify that the ECS service role being passed has the proper permissions.") {
-					return resource.RetryableError(err)
-				}
-				if isAWSErr(err, ecs.ErrCodeInvalidParameterException, "does not have an associated load balancer") {
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-
-			log.Printf("[DEBUG] Updated ECS service %s", out.Service)
-			return nil
-		})
+		output, err := retryOnAwsEventualConsistencyErrors(meta.(*AWSClient).eventualConsistencyTimeout, func() (interface{}, error) {
+			return conn.UpdateService(&input)
+		}, ecsServiceEventualConsistencyErrors...)
 		if err != nil {
 			return fmt.Errorf("error updating ECS Service (%s): %s", d.Id(), err)
 		}
+
+		log.Printf("[DEBUG] Updated ECS service %s", output.(*ecs.UpdateServiceOutput).Service)
 	}
 
 	if d.HasChange("tags") {
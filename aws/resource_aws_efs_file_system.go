@@ -93,6 +93,23 @@ func resourceAwsEfsFileSystem() *schema.Resource {
 					efs.ThroughputModeProvisioned,
 				}, false),
 			},
+
+			"lifecycle_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"transition_to_ia": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								efs.TransitionToIARulesAfter30Days,
+							}, false),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -165,6 +182,16 @@ func resourceAwsEfsFileSystemCreate(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("error setting tags for EFS file system (%q): %s", d.Id(), err)
 	}
 
+	if v, ok := d.GetOk("lifecycle_policy"); ok {
+		_, err := conn.PutLifecycleConfiguration(&efs.PutLifecycleConfigurationInput{
+			FileSystemId:      aws.String(d.Id()),
+			LifecyclePolicies: expandEfsFileSystemLifecyclePolicies(v.([]interface{})),
+		})
+		if err != nil {
+			return fmt.Errorf("error creating EFS file system (%q) lifecycle configuration: %s", d.Id(), err)
+		}
+	}
+
 	return resourceAwsEfsFileSystemRead(d, meta)
 }
 
@@ -203,6 +230,17 @@ func resourceAwsEfsFileSystemUpdate(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
+	if d.HasChange("lifecycle_policy") {
+		// An empty list of lifecycle policies removes the lifecycle configuration.
+		_, err := conn.PutLifecycleConfiguration(&efs.PutLifecycleConfigurationInput{
+			FileSystemId:      aws.String(d.Id()),
+			LifecyclePolicies: expandEfsFileSystemLifecyclePolicies(d.Get("lifecycle_policy").([]interface{})),
+		})
+		if err != nil {
+			return fmt.Errorf("error updating EFS file system (%q) lifecycle configuration: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("tags") {
 		err := setTagsEFS(conn, d)
 		if err != nil {
@@ -297,6 +335,17 @@ func resourceAwsEfsFileSystemRead(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("error setting dns_name: %s", err)
 	}
 
+	lcResp, err := conn.DescribeLifecycleConfiguration(&efs.DescribeLifecycleConfigurationInput{
+		FileSystemId: fs.FileSystemId,
+	})
+	if err != nil {
+		return fmt.Errorf("error describing EFS file system (%q) lifecycle configuration: %s", d.Id(), err)
+	}
+
+	if err := d.Set("lifecycle_policy", flattenEfsFileSystemLifecyclePolicies(lcResp.LifecyclePolicies)); err != nil {
+		return fmt.Errorf("error setting lifecycle_policy: %s", err)
+	}
+
 	return nil
 }
 
@@ -379,3 +428,36 @@ func resourceEfsFileSystemCreateUpdateRefreshFunc(id string, conn *efs.EFS) reso
 		return fs, state, nil
 	}
 }
+
+func expandEfsFileSystemLifecyclePolicies(l []interface{}) []*efs.LifecyclePolicy {
+	policies := make([]*efs.LifecyclePolicy, 0, len(l))
+
+	for _, v := range l {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		policies = append(policies, &efs.LifecyclePolicy{
+			TransitionToIA: aws.String(m["transition_to_ia"].(string)),
+		})
+	}
+
+	return policies
+}
+
+func flattenEfsFileSystemLifecyclePolicies(policies []*efs.LifecyclePolicy) []interface{} {
+	l := make([]interface{}, 0, len(policies))
+
+	for _, policy := range policies {
+		if policy == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"transition_to_ia": aws.StringValue(policy.TransitionToIA),
+		})
+	}
+
+	return l
+}
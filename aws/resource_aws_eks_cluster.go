@@ -21,6 +21,17 @@ var eksLogTypes = []string{
 	eks.LogTypeScheduler,
 }
 
+// eksClusterEventualConsistencyErrors are returned until the cluster role and its policies propagate.
+var eksClusterEventualConsistencyErrors = []awsEventualConsistencyError{
+	// InvalidParameterException: roleArn, arn:aws:iam::123456789012:role/XXX, does not exist
+	{code: eks.ErrCodeInvalidParameterException, message: "does not exist"},
+	{code: eks.ErrCodeInvalidParameterException, message: "Role could not be assumed because the trusted entity is not correct"},
+	// InvalidParameterException: The provided role doesn't have the Amazon EKS Managed Policies associated with it. Please ensure the following policies [arn:aws:iam::aws:policy/AmazonEKSClusterPolicy, arn:aws:iam::aws:policy/AmazonEKSServicePolicy] are attached
+	{code: eks.ErrCodeInvalidParameterException, message: "The provided role doesn't have the Amazon EKS Managed Policies associated with it"},
+	// InvalidParameterException: IAM role's policy must include the `ec2:DescribeSubnets` action
+	{code: eks.ErrCodeInvalidParameterException, message: "IAM role's policy must include"},
+}
+
 func resourceAwsEksCluster() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsEksClusterCreate,
@@ -151,28 +162,9 @@ func resourceAwsEksClusterCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	log.Printf("[DEBUG] Creating EKS Cluster: %s", input)
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-		_, err := conn.CreateCluster(input)
-		if err != nil {
-			// InvalidParameterException: roleArn, arn:aws:iam::123456789012:role/XXX, does not exist
-			if isAWSErr(err, eks.ErrCodeInvalidParameterException, "does not exist") {
-				return resource.RetryableError(err)
-			}
-			if isAWSErr(err, eks.ErrCodeInvalidParameterException, "Role could not be assumed because the trusted entity is not correct") {
-				return resource.RetryableError(err)
-			}
-			// InvalidParameterException: The provided role doesn't have the Amazon EKS Managed Policies associated with it. Please ensure the following policies [arn:aws:iam::aws:policy/AmazonEKSClusterPolicy, arn:aws:iam::aws:policy/AmazonEKSServicePolicy] are attached
-			if isAWSErr(err, eks.ErrCodeInvalidParameterException, "The provided role doesn't have the Amazon EKS Managed Policies associated with it") {
-				return resource.RetryableError(err)
-			}
-			// InvalidParameterException: IAM role's policy must include the `ec2:DescribeSubnets` action
-			if isAWSErr(err, eks.ErrCodeInvalidParameterException, "IAM role's policy must include") {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	_, err := retryOnAwsEventualConsistencyErrors(meta.(*AWSClient).eventualConsistencyTimeout, func() (interface{}, error) {
+		return conn.CreateCluster(input)
+	}, eksClusterEventualConsistencyErrors...)
 
 	if err != nil {
 		return fmt.Errorf("error creating EKS Cluster (%s): %s", name, err)
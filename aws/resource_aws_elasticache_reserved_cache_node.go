@@ -0,0 +1,253 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	elasticacheReservedCacheNodeStateActive         = "active"
+	elasticacheReservedCacheNodeStatePaymentPending = "payment-pending"
+)
+
+func resourceAwsElasticacheReservedCacheNode() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsElasticacheReservedCacheNodeCreate,
+		Read:   resourceAwsElasticacheReservedCacheNodeRead,
+		// Allow confirm_purchase update
+		Update: schema.Noop,
+		Delete: resourceAwsElasticacheReservedCacheNodeDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cache_node_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"cache_node_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"confirm_purchase": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"duration": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"fixed_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"offering_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"offering_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"product_description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"recurring_charges": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"recurring_charge_amount": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"recurring_charge_frequency": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"reservation_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"usage_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsElasticacheReservedCacheNodeCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elasticacheconn
+
+	// The purchase is billed immediately and cannot be undone
+	if !d.Get("confirm_purchase").(bool) {
+		return fmt.Errorf("refusing to purchase ElastiCache Reserved Cache Node offering (%s): confirm_purchase must be set to true", d.Get("offering_id").(string))
+	}
+
+	input := &elasticache.PurchaseReservedCacheNodesOfferingInput{
+		CacheNodeCount:               aws.Int64(int64(d.Get("cache_node_count").(int))),
+		ReservedCacheNodesOfferingId: aws.String(d.Get("offering_id").(string)),
+	}
+
+	if v, ok := d.GetOk("reservation_id"); ok {
+		input.ReservedCacheNodeId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Purchasing ElastiCache Reserved Cache Node: %s", input)
+	output, err := conn.PurchaseReservedCacheNodesOffering(input)
+
+	if err != nil {
+		return fmt.Errorf("error purchasing ElastiCache Reserved Cache Node: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.ReservedCacheNode.ReservedCacheNodeId))
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{elasticacheReservedCacheNodeStatePaymentPending},
+		Target:  []string{elasticacheReservedCacheNodeStateActive},
+		Refresh: func() (interface{}, string, error) {
+			reservation, err := describeElasticacheReservedCacheNode(conn, d.Id())
+
+			if err != nil {
+				return nil, "", err
+			}
+
+			if reservation == nil {
+				return nil, "", nil
+			}
+
+			return reservation, aws.StringValue(reservation.State), nil
+		},
+		Timeout: d.Timeout(schema.TimeoutCreate),
+	}
+
+	log.Printf("[DEBUG] Waiting for ElastiCache Reserved Cache Node (%s) to become active", d.Id())
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for ElastiCache Reserved Cache Node (%s) to become active: %s", d.Id(), err)
+	}
+
+	return resourceAwsElasticacheReservedCacheNodeRead(d, meta)
+}
+
+func resourceAwsElasticacheReservedCacheNodeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elasticacheconn
+
+	reservation, err := describeElasticacheReservedCacheNode(conn, d.Id())
+
+	if isAWSErr(err, elasticache.ErrCodeReservedCacheNodeNotFoundFault, "") {
+		log.Printf("[WARN] ElastiCache Reserved Cache Node (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading ElastiCache Reserved Cache Node (%s): %s", d.Id(), err)
+	}
+
+	if reservation == nil {
+		log.Printf("[WARN] ElastiCache Reserved Cache Node (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", reservation.ReservationARN)
+	d.Set("cache_node_count", reservation.CacheNodeCount)
+	d.Set("cache_node_type", reservation.CacheNodeType)
+	d.Set("duration", reservation.Duration)
+	d.Set("fixed_price", reservation.FixedPrice)
+	d.Set("offering_id", reservation.ReservedCacheNodesOfferingId)
+	d.Set("offering_type", reservation.OfferingType)
+	d.Set("product_description", reservation.ProductDescription)
+	d.Set("reservation_id", reservation.ReservedCacheNodeId)
+	d.Set("state", reservation.State)
+	d.Set("usage_price", reservation.UsagePrice)
+
+	if reservation.StartTime != nil {
+		d.Set("start_time", aws.TimeValue(reservation.StartTime).Format(time.RFC3339))
+	}
+
+	if err := d.Set("recurring_charges", flattenElasticacheRecurringCharges(reservation.RecurringCharges)); err != nil {
+		return fmt.Errorf("error setting recurring_charges: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsElasticacheReservedCacheNodeDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARN] ElastiCache Reserved Cache Node (%s) cannot be cancelled, removing from state only", d.Id())
+
+	return nil
+}
+
+func describeElasticacheReservedCacheNode(conn *elasticache.ElastiCache, reservationId string) (*elasticache.ReservedCacheNode, error) {
+	output, err := conn.DescribeReservedCacheNodes(&elasticache.DescribeReservedCacheNodesInput{
+		ReservedCacheNodeId: aws.String(reservationId),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, reservation := range output.ReservedCacheNodes {
+		if aws.StringValue(reservation.ReservedCacheNodeId) == reservationId {
+			return reservation, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func flattenElasticacheRecurringCharges(recurringCharges []*elasticache.RecurringCharge) []interface{} {
+	l := make([]interface{}, 0, len(recurringCharges))
+
+	for _, recurringCharge := range recurringCharges {
+		if recurringCharge == nil {
+			continue
+		}
+
+		m := map[string]interface{}{
+			"recurring_charge_amount":    aws.Float64Value(recurringCharge.RecurringChargeAmount),
+			"recurring_charge_frequency": aws.StringValue(recurringCharge.RecurringChargeFrequency),
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
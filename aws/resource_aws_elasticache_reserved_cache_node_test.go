@@ -0,0 +1,132 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSElasticacheReservedCacheNode_basic(t *testing.T) {
+	// Purchasing a reservation cannot be undone and is billed immediately.
+	if os.Getenv("RUN_ELASTICACHE_RESERVED_CACHE_NODE_TESTS") != "true" {
+		t.Skip("Environment variable RUN_ELASTICACHE_RESERVED_CACHE_NODE_TESTS is not set to true")
+	}
+
+	var reservation elasticache.ReservedCacheNode
+	resourceName := "aws_elasticache_reserved_cache_node.test"
+	dataSourceName := "data.aws_elasticache_reserved_cache_node_offering.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSElasticacheReservedCacheNodeConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSElasticacheReservedCacheNodeExists(resourceName, &reservation),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`:reserved-instance:`)),
+					resource.TestCheckResourceAttr(resourceName, "cache_node_count", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "cache_node_type", dataSourceName, "cache_node_type"),
+					resource.TestCheckResourceAttrPair(resourceName, "duration", dataSourceName, "duration"),
+					resource.TestCheckResourceAttrPair(resourceName, "offering_id", dataSourceName, "offering_id"),
+					resource.TestCheckResourceAttr(resourceName, "reservation_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "state", elasticacheReservedCacheNodeStateActive),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"confirm_purchase"},
+			},
+		},
+	})
+}
+
+func TestAccAWSElasticacheReservedCacheNode_ConfirmPurchaseFalse(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSElasticacheReservedCacheNodeConfig_confirmPurchaseFalse(),
+				ExpectError: regexp.MustCompile(`confirm_purchase must be set to true`),
+			},
+		},
+	})
+}
+
+func TestResourceAwsElasticacheReservedCacheNodeCreate_ConfirmPurchaseFalse(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceAwsElasticacheReservedCacheNode().Schema, map[string]interface{}{
+		"confirm_purchase": false,
+		"offering_id":      "test-offering",
+	})
+
+	// A nil service client fails the test if a purchase is attempted
+	err := resourceAwsElasticacheReservedCacheNodeCreate(d, &AWSClient{})
+
+	if err == nil || !strings.Contains(err.Error(), "confirm_purchase must be set to true") {
+		t.Fatalf("expected confirm_purchase error, got: %v", err)
+	}
+
+	if d.Id() != "" {
+		t.Errorf("expected no ID to be set, got %q", d.Id())
+	}
+}
+
+func testAccCheckAWSElasticacheReservedCacheNodeExists(resourceName string, reservation *elasticache.ReservedCacheNode) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ElastiCache Reserved Cache Node ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+
+		output, err := describeElasticacheReservedCacheNode(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("ElastiCache Reserved Cache Node (%s) not found", rs.Primary.ID)
+		}
+
+		*reservation = *output
+
+		return nil
+	}
+}
+
+func testAccAWSElasticacheReservedCacheNodeConfig_basic(rName string) string {
+	return testAccAWSElasticacheReservedCacheNodeOfferingDataSourceConfig_basic + fmt.Sprintf(`
+resource "aws_elasticache_reserved_cache_node" "test" {
+  confirm_purchase = true
+  offering_id      = "${data.aws_elasticache_reserved_cache_node_offering.test.offering_id}"
+  reservation_id   = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSElasticacheReservedCacheNodeConfig_confirmPurchaseFalse() string {
+	return testAccAWSElasticacheReservedCacheNodeOfferingDataSourceConfig_basic + `
+resource "aws_elasticache_reserved_cache_node" "test" {
+  confirm_purchase = false
+  offering_id      = "${data.aws_elasticache_reserved_cache_node_offering.test.offering_id}"
+}
+`
+}
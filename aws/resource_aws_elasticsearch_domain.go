@@ -17,6 +17,16 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 )
 
+// elasticsearchDomainEventualConsistencyErrors are returned until IAM roles referenced by the
+// domain propagate, or a previous domain with the same name finishes deleting.
+var elasticsearchDomainEventualConsistencyErrors = []awsEventualConsistencyError{
+	{code: "InvalidTypeException", message: "Error setting policy"},
+	{code: "ValidationException", message: "enable a service-linked role to give Amazon ES permissions"},
+	{code: "ValidationException", message: "Domain is still being deleted"},
+	{code: "ValidationException", message: "Amazon Elasticsearch must be allowed to use the passed role"},
+	{code: "ValidationException", message: "The passed role has not propagated yet"},
+}
+
 func resourceAwsElasticSearchDomain() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsElasticSearchDomainCreate,
@@ -434,37 +444,15 @@ func resourceAwsElasticSearchDomainCreate(d *schema.ResourceData, meta interface
 	log.Printf("[DEBUG] Creating ElasticSearch domain: %s", input)
 
 	// IAM Roles can take some time to propagate if set in AccessPolicies and created in the same terraform
-	var out *elasticsearch.CreateElasticsearchDomainOutput
-	err = resource.Retry(30*time.Second, func() *resource.RetryError {
-		var err error
-		out, err = conn.CreateElasticsearchDomain(&input)
-		if err != nil {
-			if isAWSErr(err, "InvalidTypeException", "Error setting policy") {
-				log.Printf("[DEBUG] Retrying creation of ElasticSearch domain %s", aws.StringValue(input.DomainName))
-				return resource.RetryableError(err)
-			}
-			if isAWSErr(err, "ValidationException", "enable a service-linked role to give Amazon ES permissions") {
-				return resource.RetryableError(err)
-			}
-			if isAWSErr(err, "ValidationException", "Domain is still being deleted") {
-				return resource.RetryableError(err)
-			}
-			if isAWSErr(err, "ValidationException", "Amazon Elasticsearch must be allowed to use the passed role") {
-				return resource.RetryableError(err)
-			}
-			if isAWSErr(err, "ValidationException", "The passed role has not propagated yet") {
-				return resource.RetryableError(err)
-			}
-
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	output, err := retryOnAwsEventualConsistencyErrors(meta.(*AWSClient).eventualConsistencyTimeout, func() (interface{}, error) {
+		return conn.CreateElasticsearchDomain(&input)
+	}, elasticsearchDomainEventualConsistencyErrors...)
 
 	if err != nil {
 		return err
 	}
 
+	out := output.(*elasticsearch.CreateElasticsearchDomainOutput)
 	d.SetId(aws.StringValue(out.DomainStatus.ARN))
 
 	// Whilst the domain is being created, we can initialise the tags.
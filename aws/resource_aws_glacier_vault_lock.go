@@ -10,6 +10,13 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/jen20/awspolicyequivalence"
+)
+
+// Glacier does not define constants for the vault lock states
+const (
+	glacierVaultLockStateInProgress = "InProgress"
+	glacierVaultLockStateLocked     = "Locked"
 )
 
 func resourceAwsGlacierVaultLock() *schema.Resource {
@@ -24,11 +31,19 @@ func resourceAwsGlacierVaultLock() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"complete_lock": {
 				Type:     schema.TypeBool,
 				Required: true,
 				ForceNew: true,
 			},
+			"expiration_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"ignore_deletion_error": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -41,6 +56,10 @@ func resourceAwsGlacierVaultLock() *schema.Resource {
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
 				ValidateFunc:     validateIAMPolicyJson,
 			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"vault_name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -54,11 +73,47 @@ func resourceAwsGlacierVaultLock() *schema.Resource {
 func resourceAwsGlacierVaultLockCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).glacierconn
 	vaultName := d.Get("vault_name").(string)
+	policy := d.Get("policy").(string)
+
+	existing, err := conn.GetVaultLock(&glacier.GetVaultLockInput{
+		AccountId: aws.String("-"),
+		VaultName: aws.String(vaultName),
+	})
+
+	if err != nil && !isAWSErr(err, glacier.ErrCodeResourceNotFoundException, "") {
+		return fmt.Errorf("error reading Glacier Vault Lock (%s): %s", vaultName, err)
+	}
+
+	if existing != nil {
+		switch aws.StringValue(existing.State) {
+		case glacierVaultLockStateLocked:
+			equivalent, err := awspolicy.PoliciesAreEquivalent(aws.StringValue(existing.Policy), policy)
+			if err != nil {
+				return fmt.Errorf("error comparing Glacier Vault Lock (%s) policies: %s", vaultName, err)
+			}
+
+			if !equivalent || !d.Get("complete_lock").(bool) {
+				return fmt.Errorf("Glacier Vault (%s) is already locked with a completed policy, which cannot be changed or removed", vaultName)
+			}
+
+			log.Printf("[INFO] Glacier Vault (%s) is already locked with an equivalent policy", vaultName)
+			d.SetId(vaultName)
+
+			return resourceAwsGlacierVaultLockRead(d, meta)
+		case glacierVaultLockStateInProgress:
+			// The lock ID of an in-progress lock is only returned when it is initiated,
+			// so it must be aborted and initiated again before it can be completed
+			log.Printf("[DEBUG] Aborting in-progress Glacier Vault Lock (%s)", vaultName)
+			if err := glacierVaultLockAbort(conn, vaultName); err != nil {
+				return fmt.Errorf("error aborting in-progress Glacier Vault Lock (%s): %s", vaultName, err)
+			}
+		}
+	}
 
 	input := &glacier.InitiateVaultLockInput{
 		AccountId: aws.String("-"),
 		Policy: &glacier.VaultLockPolicy{
-			Policy: aws.String(d.Get("policy").(string)),
+			Policy: aws.String(policy),
 		},
 		VaultName: aws.String(vaultName),
 	}
@@ -75,6 +130,32 @@ func resourceAwsGlacierVaultLockCreate(d *schema.ResourceData, meta interface{})
 		return resourceAwsGlacierVaultLockRead(d, meta)
 	}
 
+	// Completing the lock is irreversible, so make sure Glacier is holding the expected policy
+	inProgress, err := conn.GetVaultLock(&glacier.GetVaultLockInput{
+		AccountId: aws.String("-"),
+		VaultName: aws.String(vaultName),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading Glacier Vault Lock (%s): %s", vaultName, err)
+	}
+
+	equivalent := false
+	if inProgress != nil {
+		equivalent, _ = awspolicy.PoliciesAreEquivalent(aws.StringValue(inProgress.Policy), policy)
+	}
+
+	if !equivalent {
+		log.Printf("[DEBUG] Aborting Glacier Vault Lock (%s) with mismatched policy", vaultName)
+		if err := glacierVaultLockAbort(conn, vaultName); err != nil {
+			return fmt.Errorf("error aborting Glacier Vault Lock (%s) with mismatched policy: %s", vaultName, err)
+		}
+
+		d.SetId("")
+
+		return fmt.Errorf("error completing Glacier Vault (%s) Lock: in-progress policy does not match configured policy, lock aborted", vaultName)
+	}
+
 	completeLockInput := &glacier.CompleteVaultLockInput{
 		LockId:    output.LockId,
 		VaultName: aws.String(vaultName),
@@ -119,8 +200,18 @@ func resourceAwsGlacierVaultLockRead(d *schema.ResourceData, meta interface{}) e
 		return nil
 	}
 
-	d.Set("complete_lock", aws.StringValue(output.State) == "Locked")
+	// Glacier removes in-progress locks once they expire, which may not be reflected immediately
+	if glacierVaultLockExpired(output, time.Now()) {
+		log.Printf("[WARN] Glacier Vault Lock (%s) expired, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("complete_lock", aws.StringValue(output.State) == glacierVaultLockStateLocked)
+	d.Set("creation_date", output.CreationDate)
+	d.Set("expiration_date", output.ExpirationDate)
 	d.Set("policy", output.Policy)
+	d.Set("state", output.State)
 	d.Set("vault_name", d.Id())
 
 	return nil
@@ -129,22 +220,44 @@ func resourceAwsGlacierVaultLockRead(d *schema.ResourceData, meta interface{}) e
 func resourceAwsGlacierVaultLockDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).glacierconn
 
+	log.Printf("[DEBUG] Aborting Glacier Vault Lock (%s)", d.Id())
+	err := glacierVaultLockAbort(conn, d.Id())
+
+	if err != nil && !d.Get("ignore_deletion_error").(bool) {
+		return fmt.Errorf("error aborting Glacier Vault Lock (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func glacierVaultLockAbort(conn *glacier.Glacier, vaultName string) error {
 	input := &glacier.AbortVaultLockInput{
-		VaultName: aws.String(d.Id()),
+		AccountId: aws.String("-"),
+		VaultName: aws.String(vaultName),
 	}
 
-	log.Printf("[DEBUG] Aborting Glacier Vault Lock (%s): %s", d.Id(), input)
 	_, err := conn.AbortVaultLock(input)
 
 	if isAWSErr(err, glacier.ErrCodeResourceNotFoundException, "") {
 		return nil
 	}
 
-	if err != nil && !d.Get("ignore_deletion_error").(bool) {
-		return fmt.Errorf("error aborting Glacier Vault Lock (%s): %s", d.Id(), err)
+	return err
+}
+
+// glacierVaultLockExpired returns whether an in-progress lock has passed its expiration date.
+func glacierVaultLockExpired(output *glacier.GetVaultLockOutput, now time.Time) bool {
+	if aws.StringValue(output.State) != glacierVaultLockStateInProgress || aws.StringValue(output.ExpirationDate) == "" {
+		return false
 	}
 
-	return nil
+	expirationDate, err := time.Parse(time.RFC3339, aws.StringValue(output.ExpirationDate))
+	if err != nil {
+		log.Printf("[WARN] error parsing Glacier Vault Lock expiration date (%s): %s", aws.StringValue(output.ExpirationDate), err)
+		return false
+	}
+
+	return now.After(expirationDate)
 }
 
 func glacierVaultLockRefreshFunc(conn *glacier.Glacier, vaultName string) resource.StateRefreshFunc {
@@ -175,8 +288,8 @@ func glacierVaultLockRefreshFunc(conn *glacier.Glacier, vaultName string) resour
 
 func waitForGlacierVaultLockCompletion(conn *glacier.Glacier, vaultName string) error {
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"InProgress"},
-		Target:  []string{"Locked"},
+		Pending: []string{glacierVaultLockStateInProgress},
+		Target:  []string{glacierVaultLockStateLocked},
 		Refresh: glacierVaultLockRefreshFunc(conn, vaultName),
 		Timeout: 5 * time.Minute,
 	}
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/glacier"
@@ -27,6 +28,9 @@ func TestAccAWSGlacierVaultLock_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckGlacierVaultLockExists(resourceName, &vaultLock1),
 					resource.TestCheckResourceAttr(resourceName, "complete_lock", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date"),
+					resource.TestCheckResourceAttrSet(resourceName, "expiration_date"),
+					resource.TestCheckResourceAttr(resourceName, "state", "InProgress"),
 					resource.TestCheckResourceAttr(resourceName, "ignore_deletion_error", "false"),
 					resource.TestCheckResourceAttrSet(resourceName, "policy"),
 					resource.TestCheckResourceAttrPair(resourceName, "vault_name", glacierVaultResourceName, "name"),
@@ -58,6 +62,8 @@ func TestAccAWSGlacierVaultLock_CompleteLock(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckGlacierVaultLockExists(resourceName, &vaultLock1),
 					resource.TestCheckResourceAttr(resourceName, "complete_lock", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date"),
+					resource.TestCheckResourceAttr(resourceName, "state", "Locked"),
 					resource.TestCheckResourceAttr(resourceName, "ignore_deletion_error", "true"),
 					resource.TestCheckResourceAttrSet(resourceName, "policy"),
 					resource.TestCheckResourceAttrPair(resourceName, "vault_name", glacierVaultResourceName, "name"),
@@ -73,6 +79,59 @@ func TestAccAWSGlacierVaultLock_CompleteLock(t *testing.T) {
 	})
 }
 
+func TestGlacierVaultLockExpired(t *testing.T) {
+	now := time.Date(2019, 6, 2, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name   string
+		output *glacier.GetVaultLockOutput
+		want   bool
+	}{
+		{
+			name: "in progress not expired",
+			output: &glacier.GetVaultLockOutput{
+				ExpirationDate: aws.String("2019-06-02T13:00:00.000Z"),
+				State:          aws.String("InProgress"),
+			},
+			want: false,
+		},
+		{
+			name: "in progress expired",
+			output: &glacier.GetVaultLockOutput{
+				ExpirationDate: aws.String("2019-06-02T11:00:00.000Z"),
+				State:          aws.String("InProgress"),
+			},
+			want: true,
+		},
+		{
+			name: "in progress invalid expiration date",
+			output: &glacier.GetVaultLockOutput{
+				ExpirationDate: aws.String("invalid"),
+				State:          aws.String("InProgress"),
+			},
+			want: false,
+		},
+		{
+			name: "locked",
+			output: &glacier.GetVaultLockOutput{
+				ExpirationDate: aws.String("2019-06-02T11:00:00.000Z"),
+				State:          aws.String("Locked"),
+			},
+			want: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := glacierVaultLockExpired(testCase.output, now)
+
+			if got != testCase.want {
+				t.Errorf("got %t, expected %t", got, testCase.want)
+			}
+		})
+	}
+}
+
 func testAccCheckGlacierVaultLockExists(resourceName string, getVaultLockOutput *glacier.GetVaultLockOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -20,12 +20,14 @@ func resourceAwsGlueJob() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceAwsGlueJobCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"allocated_capacity": {
 				Type:          schema.TypeInt,
 				Optional:      true,
 				Computed:      true,
-				ConflictsWith: []string{"max_capacity"},
+				ConflictsWith: []string{"max_capacity", "number_of_workers", "worker_type"},
 				Deprecated:    "Please use attribute `max_capacity' instead. This attribute might be removed in future releases.",
 				ValidateFunc:  validation.IntAtLeast(2),
 			},
@@ -80,7 +82,7 @@ func resourceAwsGlueJob() *schema.Resource {
 				Type:          schema.TypeFloat,
 				Optional:      true,
 				Computed:      true,
-				ConflictsWith: []string{"allocated_capacity"},
+				ConflictsWith: []string{"allocated_capacity", "number_of_workers", "worker_type"},
 			},
 			"max_retries": {
 				Type:         schema.TypeInt,
@@ -107,10 +109,45 @@ func resourceAwsGlueJob() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"worker_type": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"allocated_capacity", "max_capacity"},
+				ValidateFunc: validation.StringInSlice([]string{
+					glue.WorkerTypeStandard,
+					glue.WorkerTypeG1x,
+					glue.WorkerTypeG2x,
+				}, false),
+			},
+			"number_of_workers": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"allocated_capacity", "max_capacity"},
+				ValidateFunc:  validation.IntAtLeast(2),
+			},
 		},
 	}
 }
 
+func resourceAwsGlueJobCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	if !diff.NewValueKnown("worker_type") || !diff.NewValueKnown("number_of_workers") {
+		return nil
+	}
+
+	_, workerTypeOk := diff.GetOk("worker_type")
+	_, numberOfWorkersOk := diff.GetOk("number_of_workers")
+
+	if workerTypeOk && !numberOfWorkersOk {
+		return fmt.Errorf("number_of_workers is required when worker_type is set")
+	}
+
+	if numberOfWorkersOk && !workerTypeOk {
+		return fmt.Errorf("worker_type is required when number_of_workers is set")
+	}
+
+	return nil
+}
+
 func resourceAwsGlueJobCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).glueconn
 	name := d.Get("name").(string)
@@ -161,6 +198,14 @@ func resourceAwsGlueJobCreate(d *schema.ResourceData, meta interface{}) error {
 		input.SecurityConfiguration = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("worker_type"); ok {
+		input.WorkerType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("number_of_workers"); ok {
+		input.NumberOfWorkers = aws.Int64(int64(v.(int)))
+	}
+
 	log.Printf("[DEBUG] Creating Glue Job: %s", input)
 	_, err := conn.CreateJob(input)
 	if err != nil {
@@ -218,6 +263,8 @@ func resourceAwsGlueJobRead(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("security_configuration", job.SecurityConfiguration); err != nil {
 		return fmt.Errorf("error setting security_configuration: %s", err)
 	}
+	d.Set("worker_type", job.WorkerType)
+	d.Set("number_of_workers", int(aws.Int64Value(job.NumberOfWorkers)))
 
 	// TODO: Deprecated fields - remove in next major version
 	d.Set("allocated_capacity", int(aws.Int64Value(job.AllocatedCapacity)))
@@ -234,7 +281,14 @@ func resourceAwsGlueJobUpdate(d *schema.ResourceData, meta interface{}) error {
 		Timeout: aws.Int64(int64(d.Get("timeout").(int))),
 	}
 
-	if v, ok := d.GetOk("max_capacity"); ok {
+	// The computed max_capacity of a job sized by workers must not be sent
+	// back, as the API rejects it together with worker_type.
+	_, workerTypeOk := d.GetOk("worker_type")
+	_, numberOfWorkersOk := d.GetOk("number_of_workers")
+	if workerTypeOk || numberOfWorkersOk {
+		jobUpdate.WorkerType = aws.String(d.Get("worker_type").(string))
+		jobUpdate.NumberOfWorkers = aws.Int64(int64(d.Get("number_of_workers").(int)))
+	} else if v, ok := d.GetOk("max_capacity"); ok {
 		jobUpdate.MaxCapacity = aws.Float64(v.(float64))
 	}
 
@@ -446,6 +446,50 @@ func TestAccAWSGlueJob_MaxCapacity(t *testing.T) {
 	})
 }
 
+func TestAccAWSGlueJob_WorkerType(t *testing.T) {
+	var job glue.Job
+
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "aws_glue_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSGlueJobConfig_WorkerTypeOnly(rName, "Standard"),
+				ExpectError: regexp.MustCompile(`number_of_workers is required when worker_type is set`),
+			},
+			{
+				Config:      testAccAWSGlueJobConfig_NumberOfWorkersOnly(rName, 2),
+				ExpectError: regexp.MustCompile(`worker_type is required when number_of_workers is set`),
+			},
+			{
+				Config: testAccAWSGlueJobConfig_WorkerType(rName, "Standard", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueJobExists(resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "worker_type", "Standard"),
+					resource.TestCheckResourceAttr(resourceName, "number_of_workers", "2"),
+				),
+			},
+			{
+				Config: testAccAWSGlueJobConfig_WorkerType(rName, "G.1X", 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueJobExists(resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "worker_type", "G.1X"),
+					resource.TestCheckResourceAttr(resourceName, "number_of_workers", "10"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckAWSGlueJobExists(resourceName string, job *glue.Job) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -753,3 +797,58 @@ resource "aws_glue_job" "test" {
 }
 `, testAccAWSGlueJobConfig_Base(rName), rName, maxCapacity)
 }
+
+func testAccAWSGlueJobConfig_WorkerType(rName, workerType string, numberOfWorkers int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_glue_job" "test" {
+  name              = "%s"
+  role_arn          = "${aws_iam_role.test.arn}"
+  worker_type       = "%s"
+  number_of_workers = %d
+
+  command {
+    script_location = "testscriptlocation"
+  }
+
+  depends_on = ["aws_iam_role_policy_attachment.test"]
+}
+`, testAccAWSGlueJobConfig_Base(rName), rName, workerType, numberOfWorkers)
+}
+
+func testAccAWSGlueJobConfig_WorkerTypeOnly(rName, workerType string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_glue_job" "test" {
+  name        = "%s"
+  role_arn    = "${aws_iam_role.test.arn}"
+  worker_type = "%s"
+
+  command {
+    script_location = "testscriptlocation"
+  }
+
+  depends_on = ["aws_iam_role_policy_attachment.test"]
+}
+`, testAccAWSGlueJobConfig_Base(rName), rName, workerType)
+}
+
+func testAccAWSGlueJobConfig_NumberOfWorkersOnly(rName string, numberOfWorkers int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_glue_job" "test" {
+  name              = "%s"
+  role_arn          = "${aws_iam_role.test.arn}"
+  number_of_workers = %d
+
+  command {
+    script_location = "testscriptlocation"
+  }
+
+  depends_on = ["aws_iam_role_policy_attachment.test"]
+}
+`, testAccAWSGlueJobConfig_Base(rName), rName, numberOfWorkers)
+}
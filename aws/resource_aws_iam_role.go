@@ -166,20 +166,15 @@ func resourceAwsIamRoleCreate(d *schema.ResourceData, meta interface{}) error {
 		request.Tags = tagsFromMapIAM(v.(map[string]interface{}))
 	}
 
-	var createResp *iam.CreateRoleOutput
-	err := resource.Retry(30*time.Second, func() *resource.RetryError {
-		var err error
-		createResp, err = iamconn.CreateRole(request)
-		// IAM users (referenced in Principal field of assume policy)
-		// can take ~30 seconds to propagate in AWS
-		if isAWSErr(err, "MalformedPolicyDocument", "Invalid principal in policy") {
-			return resource.RetryableError(err)
-		}
-		return resource.NonRetryableError(err)
-	})
+	// IAM users (referenced in Principal field of assume policy)
+	// can take ~30 seconds to propagate in AWS
+	output, err := retryOnAwsEventualConsistencyErrors(meta.(*AWSClient).eventualConsistencyTimeout, func() (interface{}, error) {
+		return iamconn.CreateRole(request)
+	}, awsEventualConsistencyError{code: "MalformedPolicyDocument", message: "Invalid principal in policy"})
 	if err != nil {
 		return fmt.Errorf("Error creating IAM Role %s: %s", name, err)
 	}
+	createResp := output.(*iam.CreateRoleOutput)
 	d.SetId(*createResp.Role.RoleName)
 	return resourceAwsIamRoleRead(d, meta)
 }
@@ -14,6 +14,17 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 )
 
+// kinesisAnalyticsApplicationEventualConsistencyErrors are returned until the
+// application roles and their policies propagate.
+var kinesisAnalyticsApplicationEventualConsistencyErrors = []awsEventualConsistencyError{
+	// Kinesis Stream: https://github.com/terraform-providers/terraform-provider-aws/issues/7032
+	{code: kinesisanalytics.ErrCodeInvalidArgumentException, message: "Kinesis Analytics service doesn't have sufficient privileges"},
+	// Kinesis Firehose: https://github.com/terraform-providers/terraform-provider-aws/issues/7394
+	{code: kinesisanalytics.ErrCodeInvalidArgumentException, message: "Kinesis Analytics doesn't have sufficient privileges"},
+	// InvalidArgumentException: Given IAM role arn : arn:aws:iam::123456789012:role/xxx does not provide Invoke permissions on the Lambda resource : arn:aws:lambda:us-west-2:123456789012:function:yyy
+	{code: kinesisanalytics.ErrCodeInvalidArgumentException, message: "does not provide Invoke permissions on the Lambda resource"},
+}
+
 func resourceAwsKinesisAnalyticsApplication() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsKinesisAnalyticsApplicationCreate,
@@ -594,30 +605,15 @@ func resourceAwsKinesisAnalyticsApplicationCreate(d *schema.ResourceData, meta i
 	}
 
 	// Retry for IAM eventual consistency
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-		output, err := conn.CreateApplication(createOpts)
-		if err != nil {
-			// Kinesis Stream: https://github.com/terraform-providers/terraform-provider-aws/issues/7032
-			if isAWSErr(err, kinesisanalytics.ErrCodeInvalidArgumentException, "Kinesis Analytics service doesn't have sufficient privileges") {
-				return resource.RetryableError(err)
-			}
-			// Kinesis Firehose: https://github.com/terraform-providers/terraform-provider-aws/issues/7394
-			if isAWSErr(err, kinesisanalytics.ErrCodeInvalidArgumentException, "Kinesis Analytics doesn't have sufficient privileges") {
-				return resource.RetryableError(err)
-			}
-			// InvalidArgumentException: Given IAM role arn : arn:aws:iam::123456789012:role/xxx does not provide Invoke permissions on the Lambda resource : arn:aws:lambda:us-west-2:123456789012:function:yyy
-			if isAWSErr(err, kinesisanalytics.ErrCodeInvalidArgumentException, "does not provide Invoke permissions on the Lambda resource") {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		d.SetId(aws.StringValue(output.ApplicationSummary.ApplicationARN))
-		return nil
-	})
+	output, err := retryOnAwsEventualConsistencyErrors(meta.(*AWSClient).eventualConsistencyTimeout, func() (interface{}, error) {
+		return conn.CreateApplication(createOpts)
+	}, kinesisAnalyticsApplicationEventualConsistencyErrors...)
 	if err != nil {
 		return fmt.Errorf("Unable to create Kinesis Analytics application: %s", err)
 	}
 
+	d.SetId(aws.StringValue(output.(*kinesisanalytics.CreateApplicationOutput).ApplicationSummary.ApplicationARN))
+
 	return resourceAwsKinesisAnalyticsApplicationUpdate(d, meta)
 }
 
@@ -712,16 +708,9 @@ func resourceAwsKinesisAnalyticsApplicationUpdate(d *schema.ResourceData, meta i
 					CloudWatchLoggingOption:     cloudwatchLoggingOption,
 				}
 				// Retry for IAM eventual consistency
-				err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-					_, err := conn.AddApplicationCloudWatchLoggingOption(addOpts)
-					if err != nil {
-						if isAWSErr(err, kinesisanalytics.ErrCodeInvalidArgumentException, "Kinesis Analytics service doesn't have sufficient privileges") {
-							return resource.RetryableError(err)
-						}
-						return resource.NonRetryableError(err)
-					}
-					return nil
-				})
+				_, err := retryOnAwsEventualConsistencyErrors(meta.(*AWSClient).eventualConsistencyTimeout, func() (interface{}, error) {
+					return conn.AddApplicationCloudWatchLoggingOption(addOpts)
+				}, kinesisAnalyticsApplicationEventualConsistencyErrors...)
 				if err != nil {
 					return fmt.Errorf("Unable to add CloudWatch logging options: %s", err)
 				}
@@ -740,20 +729,9 @@ func resourceAwsKinesisAnalyticsApplicationUpdate(d *schema.ResourceData, meta i
 					Input:                       input,
 				}
 				// Retry for IAM eventual consistency
-				err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-					_, err := conn.AddApplicationInput(addOpts)
-					if err != nil {
-						if isAWSErr(err, kinesisanalytics.ErrCodeInvalidArgumentException, "Kinesis Analytics service doesn't have sufficient privileges") {
-							return resource.RetryableError(err)
-						}
-						// InvalidArgumentException: Given IAM role arn : arn:aws:iam::123456789012:role/xxx does not provide Invoke permissions on the Lambda resource : arn:aws:lambda:us-west-2:123456789012:function:yyy
-						if isAWSErr(err, kinesisanalytics.ErrCodeInvalidArgumentException, "does not provide Invoke permissions on the Lambda resource") {
-							return resource.RetryableError(err)
-						}
-						return resource.NonRetryableError(err)
-					}
-					return nil
-				})
+				_, err := retryOnAwsEventualConsistencyErrors(meta.(*AWSClient).eventualConsistencyTimeout, func() (interface{}, error) {
+					return conn.AddApplicationInput(addOpts)
+				}, kinesisAnalyticsApplicationEventualConsistencyErrors...)
 				if err != nil {
 					return fmt.Errorf("Unable to add application inputs: %s", err)
 				}
@@ -772,20 +750,9 @@ func resourceAwsKinesisAnalyticsApplicationUpdate(d *schema.ResourceData, meta i
 					Output:                      output,
 				}
 				// Retry for IAM eventual consistency
-				err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-					_, err := conn.AddApplicationOutput(addOpts)
-					if err != nil {
-						if isAWSErr(err, kinesisanalytics.ErrCodeInvalidArgumentException, "Kinesis Analytics service doesn't have sufficient privileges") {
-							return resource.RetryableError(err)
-						}
-						// InvalidArgumentException: Given IAM role arn : arn:aws:iam::123456789012:role/xxx does not provide Invoke permissions on the Lambda resource : arn:aws:lambda:us-west-2:123456789012:function:yyy
-						if isAWSErr(err, kinesisanalytics.ErrCodeInvalidArgumentException, "does not provide Invoke permissions on the Lambda resource") {
-							return resource.RetryableError(err)
-						}
-						return resource.NonRetryableError(err)
-					}
-					return nil
-				})
+				_, err := retryOnAwsEventualConsistencyErrors(meta.(*AWSClient).eventualConsistencyTimeout, func() (interface{}, error) {
+					return conn.AddApplicationOutput(addOpts)
+				}, kinesisAnalyticsApplicationEventualConsistencyErrors...)
 				if err != nil {
 					return fmt.Errorf("Unable to add application outputs: %s", err)
 				}
@@ -811,16 +778,9 @@ func resourceAwsKinesisAnalyticsApplicationUpdate(d *schema.ResourceData, meta i
 					ReferenceDataSource:         referenceData,
 				}
 				// Retry for IAM eventual consistency
-				err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-					_, err := conn.AddApplicationReferenceDataSource(addOpts)
-					if err != nil {
-						if isAWSErr(err, kinesisanalytics.ErrCodeInvalidArgumentException, "Kinesis Analytics service doesn't have sufficient privileges") {
-							return resource.RetryableError(err)
-						}
-						return resource.NonRetryableError(err)
-					}
-					return nil
-				})
+				_, err := retryOnAwsEventualConsistencyErrors(meta.(*AWSClient).eventualConsistencyTimeout, func() (interface{}, error) {
+					return conn.AddApplicationReferenceDataSource(addOpts)
+				}, kinesisAnalyticsApplicationEventualConsistencyErrors...)
 				if err != nil {
 					return fmt.Errorf("Unable to add application reference data source: %s", err)
 				}
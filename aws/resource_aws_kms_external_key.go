@@ -108,36 +108,20 @@ func resourceAwsKmsExternalKeyCreate(d *schema.ResourceData, meta interface{}) e
 		input.Tags = tagsFromMapKMS(v.(map[string]interface{}))
 	}
 
-	var output *kms.CreateKeyOutput
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-		var err error
-
-		output, err = conn.CreateKey(input)
-
-		// AWS requires any principal in the policy to exist before the key is created.
-		// The KMS service's awareness of principals is limited by "eventual consistency".
-		// KMS will report this error until it can validate the policy itself.
-		// They acknowledge this here:
-		// http://docs.aws.amazon.com/kms/latest/APIReference/API_CreateKey.html
-		if isAWSErr(err, kms.ErrCodeMalformedPolicyDocumentException, "") {
-			return resource.RetryableError(err)
-		}
-
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
-	})
-
-	if isResourceTimeoutError(err) {
-		output, err = conn.CreateKey(input)
-	}
+	// AWS requires any principal in the policy to exist before the key is created.
+	// The KMS service's awareness of principals is limited by "eventual consistency".
+	// KMS will report this error until it can validate the policy itself.
+	// They acknowledge this here:
+	// http://docs.aws.amazon.com/kms/latest/APIReference/API_CreateKey.html
+	outputRaw, err := retryOnAwsEventualConsistencyErrors(meta.(*AWSClient).eventualConsistencyTimeout, func() (interface{}, error) {
+		return conn.CreateKey(input)
+	}, awsEventualConsistencyError{code: kms.ErrCodeMalformedPolicyDocumentException})
 
 	if err != nil {
 		return fmt.Errorf("error creating KMS External Key: %s", err)
 	}
 
+	output := outputRaw.(*kms.CreateKeyOutput)
 	d.SetId(aws.StringValue(output.KeyMetadata.KeyId))
 
 	if v, ok := d.GetOk("key_material_base64"); ok {
@@ -56,8 +56,17 @@ func resourceAwsLaunchTemplate() *schema.Resource {
 			},
 
 			"default_version": {
-				Type:     schema.TypeInt,
-				Computed: true,
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"update_default_version"},
+				ValidateFunc:  validation.IntAtLeast(1),
+			},
+
+			"update_default_version": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"default_version"},
 			},
 
 			"latest_version": {
@@ -498,7 +507,7 @@ func resourceAwsLaunchTemplate() *schema.Resource {
 			customdiff.ComputedIf("latest_version", func(diff *schema.ResourceDiff, meta interface{}) bool {
 				for _, changedKey := range diff.GetChangedKeysPrefix("") {
 					switch changedKey {
-					case "name", "name_prefix", "description", "default_version", "latest_version":
+					case "name", "name_prefix", "description", "default_version", "update_default_version", "latest_version":
 						continue
 					default:
 						return true
@@ -506,10 +515,27 @@ func resourceAwsLaunchTemplate() *schema.Resource {
 				}
 				return false
 			}),
+			customdiff.ComputedIf("default_version", func(diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.Get("update_default_version").(bool) && !diff.NewValueKnown("latest_version")
+			}),
+			resourceAwsLaunchTemplateCustomizeDiffDefaultVersion,
 		),
 	}
 }
 
+// A new launch template only has version 1, so no other default version can be set at create.
+func resourceAwsLaunchTemplateCustomizeDiffDefaultVersion(diff *schema.ResourceDiff, v interface{}) error {
+	if diff.Id() != "" || !diff.NewValueKnown("default_version") {
+		return nil
+	}
+
+	if version, ok := diff.GetOk("default_version"); ok && version.(int) != 1 {
+		return fmt.Errorf("default_version must be 1 when creating a launch template, got %d", version.(int))
+	}
+
+	return nil
+}
+
 func resourceAwsLaunchTemplateCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
@@ -691,7 +717,8 @@ func resourceAwsLaunchTemplateRead(d *schema.ResourceData, meta interface{}) err
 func resourceAwsLaunchTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
-	if !d.IsNewResource() {
+	// Changing only the default version must not create a new version.
+	if !d.IsNewResource() && (d.HasChange("latest_version") || d.HasChange("description")) {
 		launchTemplateData, err := buildLaunchTemplateData(d)
 		if err != nil {
 			return err
@@ -707,10 +734,24 @@ func resourceAwsLaunchTemplateUpdate(d *schema.ResourceData, meta interface{}) e
 			launchTemplateVersionOpts.VersionDescription = aws.String(v.(string))
 		}
 
-		_, createErr := conn.CreateLaunchTemplateVersion(launchTemplateVersionOpts)
+		output, createErr := conn.CreateLaunchTemplateVersion(launchTemplateVersionOpts)
 		if createErr != nil {
 			return createErr
 		}
+
+		if d.Get("update_default_version").(bool) {
+			if err := modifyLaunchTemplateDefaultVersion(conn, d.Id(), aws.Int64Value(output.LaunchTemplateVersion.VersionNumber)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !d.IsNewResource() && d.HasChange("default_version") {
+		if v, ok := d.GetOk("default_version"); ok {
+			if err := modifyLaunchTemplateDefaultVersion(conn, d.Id(), int64(v.(int))); err != nil {
+				return err
+			}
+		}
 	}
 
 	d.Partial(true)
@@ -726,6 +767,21 @@ func resourceAwsLaunchTemplateUpdate(d *schema.ResourceData, meta interface{}) e
 	return resourceAwsLaunchTemplateRead(d, meta)
 }
 
+func modifyLaunchTemplateDefaultVersion(conn *ec2.EC2, id string, version int64) error {
+	input := &ec2.ModifyLaunchTemplateInput{
+		ClientToken:      aws.String(resource.UniqueId()),
+		LaunchTemplateId: aws.String(id),
+		DefaultVersion:   aws.String(strconv.FormatInt(version, 10)),
+	}
+
+	log.Printf("[DEBUG] Setting Launch Template (%s) default version: %s", id, input)
+	if _, err := conn.ModifyLaunchTemplate(input); err != nil {
+		return fmt.Errorf("error setting Launch Template (%s) default version to %d: %s", id, version, err)
+	}
+
+	return nil
+}
+
 func resourceAwsLaunchTemplateDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -361,6 +362,60 @@ func TestAccAWSLaunchTemplate_update(t *testing.T) {
 	})
 }
 
+func TestAccAWSLaunchTemplate_defaultVersion(t *testing.T) {
+	var template ec2.LaunchTemplate
+	resName := "aws_launch_template.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLaunchTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLaunchTemplateConfig_updateDefaultVersion(rName, "t2.micro"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLaunchTemplateExists(resName, &template),
+					resource.TestCheckResourceAttr(resName, "default_version", "1"),
+					resource.TestCheckResourceAttr(resName, "latest_version", "1"),
+				),
+			},
+			{
+				Config: testAccAWSLaunchTemplateConfig_updateDefaultVersion(rName, "t2.small"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLaunchTemplateExists(resName, &template),
+					resource.TestCheckResourceAttr(resName, "default_version", "2"),
+					resource.TestCheckResourceAttr(resName, "latest_version", "2"),
+				),
+			},
+			{
+				Config: testAccAWSLaunchTemplateConfig_defaultVersion(rName, "t2.small", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLaunchTemplateExists(resName, &template),
+					resource.TestCheckResourceAttr(resName, "default_version", "1"),
+					resource.TestCheckResourceAttr(resName, "latest_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLaunchTemplate_defaultVersionCreate(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLaunchTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSLaunchTemplateConfig_defaultVersion(rName, "t2.micro", 2),
+				ExpectError: regexp.MustCompile(`default_version must be 1 when creating a launch template`),
+			},
+		},
+	})
+}
+
 func TestAccAWSLaunchTemplate_tags(t *testing.T) {
 	var template ec2.LaunchTemplate
 	resName := "aws_launch_template.foo"
@@ -1007,6 +1062,26 @@ resource "aws_launch_template" "example" {
 `, rInt)
 }
 
+func testAccAWSLaunchTemplateConfig_updateDefaultVersion(rName, instanceType string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name                   = %[1]q
+  instance_type          = %[2]q
+  update_default_version = true
+}
+`, rName, instanceType)
+}
+
+func testAccAWSLaunchTemplateConfig_defaultVersion(rName, instanceType string, defaultVersion int) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name            = %[1]q
+  instance_type   = %[2]q
+  default_version = %[3]d
+}
+`, rName, instanceType, defaultVersion)
+}
+
 func testAccAWSLaunchTemplateConfig_description(rName, description string) string {
 	return fmt.Sprintf(`
 resource "aws_launch_template" "foo" {
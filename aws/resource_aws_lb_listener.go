@@ -31,6 +31,8 @@ func resourceAwsLbListener() *schema.Resource {
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: resourceAwsLbListenerCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -38,9 +40,10 @@ func resourceAwsLbListener() *schema.Resource {
 			},
 
 			"load_balancer_arn": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArnService("elasticloadbalancing"),
 			},
 
 			"port": {
@@ -71,8 +74,9 @@ func resourceAwsLbListener() *schema.Resource {
 			},
 
 			"certificate_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArnService("acm", "iam"),
 			},
 
 			"default_action": {
@@ -102,6 +106,7 @@ func resourceAwsLbListener() *schema.Resource {
 							Type:             schema.TypeString,
 							Optional:         true,
 							DiffSuppressFunc: suppressIfDefaultActionTypeNot("forward"),
+							ValidateFunc:     validateArnService("elasticloadbalancing"),
 						},
 
 						"redirect": {
@@ -330,6 +335,28 @@ func suppressIfDefaultActionTypeNot(t string) schema.SchemaDiffSuppressFunc {
 	}
 }
 
+func resourceAwsLbListenerCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	if !diff.NewValueKnown("protocol") {
+		return nil
+	}
+
+	protocol := strings.ToUpper(diff.Get("protocol").(string))
+
+	switch protocol {
+	case elbv2.ProtocolEnumHttps, elbv2.ProtocolEnumTls:
+		if diff.NewValueKnown("certificate_arn") && diff.Get("certificate_arn").(string) == "" {
+			return fmt.Errorf("certificate_arn is required when protocol is %s", protocol)
+		}
+	case elbv2.ProtocolEnumHttp, elbv2.ProtocolEnumTcp:
+		// ssl_policy is computed, so only reject values set in the configuration.
+		if diff.HasChange("ssl_policy") && diff.Get("ssl_policy").(string) != "" {
+			return fmt.Errorf("ssl_policy cannot be set when protocol is %s", protocol)
+		}
+	}
+
+	return nil
+}
+
 func resourceAwsLbListenerCreate(d *schema.ResourceData, meta interface{}) error {
 	elbconn := meta.(*AWSClient).elbv2conn
 
@@ -20,14 +20,16 @@ func resourceAwsLbListenerCertificate() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"listener_arn": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArnService("elasticloadbalancing"),
 			},
 			"certificate_arn": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArnService("acm", "iam"),
 			},
 		},
 	}
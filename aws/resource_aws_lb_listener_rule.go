@@ -71,6 +71,7 @@ func resourceAwsLbbListenerRule() *schema.Resource {
 							Type:             schema.TypeString,
 							Optional:         true,
 							DiffSuppressFunc: suppressIfActionTypeNot("forward"),
+							ValidateFunc:     validateArnService("elasticloadbalancing"),
 						},
 
 						"redirect": {
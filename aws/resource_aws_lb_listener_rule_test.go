@@ -3,6 +3,7 @@ package aws
 import (
 	"errors"
 	"fmt"
+	"log"
 	"regexp"
 	"testing"
 
@@ -13,6 +14,91 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("aws_lb_listener_rule", &resource.Sweeper{
+		Name: "aws_lb_listener_rule",
+		F:    testSweepLBListenerRules,
+	})
+}
+
+func testSweepLBListenerRules(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).elbv2conn
+
+	err = conn.DescribeLoadBalancersPages(&elbv2.DescribeLoadBalancersInput{}, func(page *elbv2.DescribeLoadBalancersOutput, isLast bool) bool {
+		if page == nil || len(page.LoadBalancers) == 0 {
+			log.Print("[DEBUG] No LB Listener Rules to sweep")
+			return false
+		}
+
+		for _, loadBalancer := range page.LoadBalancers {
+			err := conn.DescribeListenersPages(&elbv2.DescribeListenersInput{
+				LoadBalancerArn: loadBalancer.LoadBalancerArn,
+			}, func(page *elbv2.DescribeListenersOutput, isLast bool) bool {
+				for _, listener := range page.Listeners {
+					if err := testSweepLBListenerRulesForListener(conn, listener.ListenerArn); err != nil {
+						log.Printf("[ERROR] Failed to retrieve LB Listener (%s) Rules: %s", aws.StringValue(listener.ListenerArn), err)
+					}
+				}
+				return !isLast
+			})
+			if err != nil {
+				log.Printf("[ERROR] Failed to retrieve LB (%s) Listeners: %s", aws.StringValue(loadBalancer.LoadBalancerName), err)
+			}
+		}
+		return !isLast
+	})
+	if err != nil {
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] Skipping LB Listener Rule sweep for %s: %s", region, err)
+			return nil
+		}
+		return fmt.Errorf("Error retrieving LBs: %s", err)
+	}
+	return nil
+}
+
+func testSweepLBListenerRulesForListener(conn *elbv2.ELBV2, listenerArn *string) error {
+	input := &elbv2.DescribeRulesInput{
+		ListenerArn: listenerArn,
+	}
+
+	for {
+		output, err := conn.DescribeRules(input)
+		if err != nil {
+			return err
+		}
+
+		for _, rule := range output.Rules {
+			// The default rule is deleted along with its listener.
+			if aws.BoolValue(rule.IsDefault) {
+				continue
+			}
+
+			arn := aws.StringValue(rule.RuleArn)
+
+			log.Printf("[INFO] Deleting LB Listener Rule: %s", arn)
+			_, err := conn.DeleteRule(&elbv2.DeleteRuleInput{
+				RuleArn: rule.RuleArn,
+			})
+			if err != nil {
+				log.Printf("[ERROR] Failed to delete LB Listener Rule (%s): %s", arn, err)
+			}
+		}
+
+		if aws.StringValue(output.NextMarker) == "" {
+			break
+		}
+
+		input.Marker = output.NextMarker
+	}
+
+	return nil
+}
+
 func TestLBListenerARNFromRuleARN(t *testing.T) {
 	cases := []struct {
 		name     string
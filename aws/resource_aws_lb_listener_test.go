@@ -3,6 +3,8 @@ package aws
 import (
 	"errors"
 	"fmt"
+	"log"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -12,6 +14,62 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("aws_lb_listener", &resource.Sweeper{
+		Name: "aws_lb_listener",
+		F:    testSweepLBListeners,
+		Dependencies: []string{
+			"aws_lb_listener_rule",
+		},
+	})
+}
+
+func testSweepLBListeners(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).elbv2conn
+
+	err = conn.DescribeLoadBalancersPages(&elbv2.DescribeLoadBalancersInput{}, func(page *elbv2.DescribeLoadBalancersOutput, isLast bool) bool {
+		if page == nil || len(page.LoadBalancers) == 0 {
+			log.Print("[DEBUG] No LB Listeners to sweep")
+			return false
+		}
+
+		for _, loadBalancer := range page.LoadBalancers {
+			err := conn.DescribeListenersPages(&elbv2.DescribeListenersInput{
+				LoadBalancerArn: loadBalancer.LoadBalancerArn,
+			}, func(page *elbv2.DescribeListenersOutput, isLast bool) bool {
+				for _, listener := range page.Listeners {
+					arn := aws.StringValue(listener.ListenerArn)
+
+					log.Printf("[INFO] Deleting LB Listener: %s", arn)
+					_, err := conn.DeleteListener(&elbv2.DeleteListenerInput{
+						ListenerArn: listener.ListenerArn,
+					})
+					if err != nil {
+						log.Printf("[ERROR] Failed to delete LB Listener (%s): %s", arn, err)
+					}
+				}
+				return !isLast
+			})
+			if err != nil {
+				log.Printf("[ERROR] Failed to retrieve LB (%s) Listeners: %s", aws.StringValue(loadBalancer.LoadBalancerName), err)
+			}
+		}
+		return !isLast
+	})
+	if err != nil {
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] Skipping LB Listener sweep for %s: %s", region, err)
+			return nil
+		}
+		return fmt.Errorf("Error retrieving LBs: %s", err)
+	}
+	return nil
+}
+
 func TestAccAWSLBListener_basic(t *testing.T) {
 	var conf elbv2.Listener
 	lbName := fmt.Sprintf("testlistener-basic-%s", acctest.RandStringFromCharSet(13, acctest.CharSetAlphaNum))
@@ -319,6 +377,35 @@ func TestAccAWSLBListener_DefaultAction_Order_Recreates(t *testing.T) {
 	})
 }
 
+func TestAccAWSLBListener_PlanTimeValidation(t *testing.T) {
+	lbArn := "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/tf-acc-test/50dc6c495c0c9188"
+	targetGroupArn := "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/tf-acc-test/73e2d6bc24d8a067"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLBListenerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSLBListenerConfig_PlanTimeValidation(lbArn, targetGroupArn, "HTTPS", ""),
+				ExpectError: regexp.MustCompile(`certificate_arn is required when protocol is HTTPS`),
+			},
+			{
+				Config:      testAccAWSLBListenerConfig_PlanTimeValidation(lbArn, targetGroupArn, "TCP", `ssl_policy = "ELBSecurityPolicy-2016-08"`),
+				ExpectError: regexp.MustCompile(`ssl_policy cannot be set when protocol is TCP`),
+			},
+			{
+				Config:      testAccAWSLBListenerConfig_PlanTimeValidation(lbArn, targetGroupArn, "HTTPS", `certificate_arn = "arn:aws:s3:::tf-acc-test"`),
+				ExpectError: regexp.MustCompile(`must be an ARN of service acm or iam`),
+			},
+			{
+				Config:      testAccAWSLBListenerConfig_PlanTimeValidation(lbArn, "arn:aws:ec2:us-west-2:123456789012:instance/i-12345678", "HTTP", ""),
+				ExpectError: regexp.MustCompile(`must be an ARN of service elasticloadbalancing`),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSLBListenerDefaultActionOrderDisappears(listener *elbv2.Listener, actionOrderToDelete int) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		var newDefaultActions []*elbv2.Action
@@ -1409,3 +1496,19 @@ resource "aws_security_group" "test" {
   }
 }`, rName)
 }
+
+func testAccAWSLBListenerConfig_PlanTimeValidation(lbArn, targetGroupArn, protocol, extra string) string {
+	return fmt.Sprintf(`
+resource "aws_lb_listener" "test" {
+  load_balancer_arn = %[1]q
+  port              = 443
+  protocol          = %[3]q
+  %[4]s
+
+  default_action {
+    target_group_arn = %[2]q
+    type             = "forward"
+  }
+}
+`, lbArn, targetGroupArn, protocol, extra)
+}
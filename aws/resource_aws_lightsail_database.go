@@ -0,0 +1,427 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsLightsailDatabase() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLightsailDatabaseCreate,
+		Read:   resourceAwsLightsailDatabaseRead,
+		Update: resourceAwsLightsailDatabaseUpdate,
+		Delete: resourceAwsLightsailDatabaseDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(40 * time.Minute),
+			Update: schema.DefaultTimeout(40 * time.Minute),
+			Delete: schema.DefaultTimeout(40 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"blueprint_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"bundle_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"master_database_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"master_username": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"master_password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"preferred_backup_window": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"preferred_maintenance_window": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"publicly_accessible": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"backup_retention_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"apply_immediately": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"skip_final_snapshot": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"final_snapshot_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": tagsSchema(),
+
+			// additional info returned from the API
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engine": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cpu_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"disk_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"ram_size": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"master_endpoint_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"master_endpoint_port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"secondary_availability_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"support_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLightsailDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	name := d.Get("name").(string)
+	input := &lightsail.CreateRelationalDatabaseInput{
+		MasterDatabaseName:            aws.String(d.Get("master_database_name").(string)),
+		MasterUserPassword:            aws.String(d.Get("master_password").(string)),
+		MasterUsername:                aws.String(d.Get("master_username").(string)),
+		PubliclyAccessible:            aws.Bool(d.Get("publicly_accessible").(bool)),
+		RelationalDatabaseBlueprintId: aws.String(d.Get("blueprint_id").(string)),
+		RelationalDatabaseBundleId:    aws.String(d.Get("bundle_id").(string)),
+		RelationalDatabaseName:        aws.String(name),
+	}
+
+	if v, ok := d.GetOk("availability_zone"); ok {
+		input.AvailabilityZone = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("preferred_backup_window"); ok {
+		input.PreferredBackupWindow = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("preferred_maintenance_window"); ok {
+		input.PreferredMaintenanceWindow = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().LightsailTags()
+	}
+
+	log.Printf("[DEBUG] Creating Lightsail Database: %s", input)
+	resp, err := conn.CreateRelationalDatabase(input)
+	if err != nil {
+		return fmt.Errorf("error creating Lightsail Database (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if err := waitForLightsailOperations(meta, resp.Operations, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Database (%s) creation: %s", d.Id(), err)
+	}
+
+	if err := waitForLightsailDatabaseAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Database (%s) to become available: %s", d.Id(), err)
+	}
+
+	// Backup retention cannot be set on creation and is enabled by default.
+	if !d.Get("backup_retention_enabled").(bool) {
+		resp, err := conn.UpdateRelationalDatabase(&lightsail.UpdateRelationalDatabaseInput{
+			ApplyImmediately:       aws.Bool(true),
+			DisableBackupRetention: aws.Bool(true),
+			RelationalDatabaseName: aws.String(d.Id()),
+		})
+		if err != nil {
+			return fmt.Errorf("error disabling Lightsail Database (%s) backup retention: %s", d.Id(), err)
+		}
+
+		if err := waitForLightsailOperations(meta, resp.Operations, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("error waiting for Lightsail Database (%s) update: %s", d.Id(), err)
+		}
+
+		if err := waitForLightsailDatabaseAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("error waiting for Lightsail Database (%s) to become available: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsLightsailDatabaseRead(d, meta)
+}
+
+func resourceAwsLightsailDatabaseRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	resp, err := conn.GetRelationalDatabase(&lightsail.GetRelationalDatabaseInput{
+		RelationalDatabaseName: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Lightsail Database (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Database (%s): %s", d.Id(), err)
+	}
+
+	if resp == nil || resp.RelationalDatabase == nil {
+		log.Printf("[WARN] Lightsail Database (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	db := resp.RelationalDatabase
+
+	d.Set("name", db.Name)
+	d.Set("arn", db.Arn)
+	d.Set("blueprint_id", db.RelationalDatabaseBlueprintId)
+	d.Set("bundle_id", db.RelationalDatabaseBundleId)
+	d.Set("master_database_name", db.MasterDatabaseName)
+	d.Set("master_username", db.MasterUsername)
+	d.Set("preferred_backup_window", db.PreferredBackupWindow)
+	d.Set("preferred_maintenance_window", db.PreferredMaintenanceWindow)
+	d.Set("publicly_accessible", db.PubliclyAccessible)
+	d.Set("backup_retention_enabled", db.BackupRetentionEnabled)
+	d.Set("engine", db.Engine)
+	d.Set("engine_version", db.EngineVersion)
+	d.Set("secondary_availability_zone", db.SecondaryAvailabilityZone)
+	d.Set("support_code", db.SupportCode)
+
+	if db.CreatedAt != nil {
+		d.Set("created_at", db.CreatedAt.Format(time.RFC3339))
+	}
+
+	if db.Location != nil {
+		d.Set("availability_zone", db.Location.AvailabilityZone)
+	}
+
+	if db.Hardware != nil {
+		d.Set("cpu_count", db.Hardware.CpuCount)
+		d.Set("disk_size", db.Hardware.DiskSizeInGb)
+		d.Set("ram_size", db.Hardware.RamSizeInGb)
+	}
+
+	if db.MasterEndpoint != nil {
+		d.Set("master_endpoint_address", db.MasterEndpoint.Address)
+		d.Set("master_endpoint_port", db.MasterEndpoint.Port)
+	}
+
+	if err := d.Set("tags", keyvaluetags.LightsailKeyValueTags(db.Tags).IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsLightsailDatabaseUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	input := &lightsail.UpdateRelationalDatabaseInput{
+		ApplyImmediately:       aws.Bool(d.Get("apply_immediately").(bool)),
+		RelationalDatabaseName: aws.String(d.Id()),
+	}
+	requestUpdate := false
+
+	if d.HasChange("master_password") {
+		input.MasterUserPassword = aws.String(d.Get("master_password").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("preferred_backup_window") {
+		input.PreferredBackupWindow = aws.String(d.Get("preferred_backup_window").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("preferred_maintenance_window") {
+		input.PreferredMaintenanceWindow = aws.String(d.Get("preferred_maintenance_window").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("publicly_accessible") {
+		input.PubliclyAccessible = aws.Bool(d.Get("publicly_accessible").(bool))
+		requestUpdate = true
+	}
+
+	if d.HasChange("backup_retention_enabled") {
+		if d.Get("backup_retention_enabled").(bool) {
+			input.EnableBackupRetention = aws.Bool(true)
+		} else {
+			input.DisableBackupRetention = aws.Bool(true)
+		}
+		requestUpdate = true
+	}
+
+	if requestUpdate {
+		log.Printf("[DEBUG] Updating Lightsail Database: %s", input)
+		resp, err := conn.UpdateRelationalDatabase(input)
+		if err != nil {
+			return fmt.Errorf("error updating Lightsail Database (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForLightsailOperations(meta, resp.Operations, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Lightsail Database (%s) update: %s", d.Id(), err)
+		}
+
+		if d.Get("apply_immediately").(bool) {
+			if err := waitForLightsailDatabaseAvailable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmt.Errorf("error waiting for Lightsail Database (%s) to become available: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if err := setTagsLightsail(conn, d, d.Id()); err != nil {
+		return fmt.Errorf("error updating Lightsail Database (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsLightsailDatabaseRead(d, meta)
+}
+
+func resourceAwsLightsailDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	input := &lightsail.DeleteRelationalDatabaseInput{
+		RelationalDatabaseName: aws.String(d.Id()),
+		SkipFinalSnapshot:      aws.Bool(d.Get("skip_final_snapshot").(bool)),
+	}
+
+	if !d.Get("skip_final_snapshot").(bool) {
+		v, ok := d.GetOk("final_snapshot_name")
+		if !ok {
+			return fmt.Errorf("error deleting Lightsail Database (%s): final_snapshot_name is required when skip_final_snapshot is false", d.Id())
+		}
+		input.FinalRelationalDatabaseSnapshotName = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Deleting Lightsail Database: %s", input)
+	resp, err := conn.DeleteRelationalDatabase(input)
+
+	if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lightsail Database (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForLightsailOperations(meta, resp.Operations, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Database (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsLightsailDatabaseStateRefreshFunc(conn *lightsail.Lightsail, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.GetRelationalDatabase(&lightsail.GetRelationalDatabaseInput{
+			RelationalDatabaseName: aws.String(name),
+		})
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if resp == nil || resp.RelationalDatabase == nil {
+			return nil, "", nil
+		}
+
+		return resp.RelationalDatabase, aws.StringValue(resp.RelationalDatabase.State), nil
+	}
+}
+
+func waitForLightsailDatabaseAvailable(conn *lightsail.Lightsail, name string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			"backing-up",
+			"configuring-log-exports",
+			"creating",
+			"maintenance",
+			"modifying",
+			"rebooting",
+			"renaming",
+			"resetting-master-credentials",
+			"starting",
+			"upgrading",
+		},
+		Target:     []string{"available"},
+		Refresh:    resourceAwsLightsailDatabaseStateRefreshFunc(conn, name),
+		Timeout:    timeout,
+		Delay:      30 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
@@ -0,0 +1,196 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLightsailDatabase_basic(t *testing.T) {
+	var db lightsail.RelationalDatabase
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lightsail_database.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLightsailDatabaseConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailDatabaseExists(resourceName, &db),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "blueprint_id", "mysql_5_7"),
+					resource.TestCheckResourceAttr(resourceName, "bundle_id", "micro_1_0"),
+					resource.TestCheckResourceAttr(resourceName, "master_database_name", "testdatabase"),
+					resource.TestCheckResourceAttr(resourceName, "master_username", "test"),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "publicly_accessible", "false"),
+					resource.TestCheckResourceAttr(resourceName, "engine", "mysql"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "availability_zone"),
+					resource.TestCheckResourceAttrSet(resourceName, "master_endpoint_address"),
+					resource.TestCheckResourceAttrSet(resourceName, "master_endpoint_port"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"apply_immediately",
+					"final_snapshot_name",
+					"master_password",
+					"skip_final_snapshot",
+				},
+			},
+		},
+	})
+}
+
+func TestAccAWSLightsailDatabase_Update(t *testing.T) {
+	var db lightsail.RelationalDatabase
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lightsail_database.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLightsailDatabaseConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailDatabaseExists(resourceName, &db),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "publicly_accessible", "false"),
+				),
+			},
+			{
+				Config: testAccAWSLightsailDatabaseConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailDatabaseExists(resourceName, &db),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "publicly_accessible", "true"),
+					resource.TestCheckResourceAttr(resourceName, "preferred_backup_window", "09:30-10:00"),
+					resource.TestCheckResourceAttr(resourceName, "preferred_maintenance_window", "tue:10:00-tue:10:30"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLightsailDatabaseExists(n string, res *lightsail.RelationalDatabase) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lightsail Database ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+		resp, err := conn.GetRelationalDatabase(&lightsail.GetRelationalDatabaseInput{
+			RelationalDatabaseName: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if resp == nil || resp.RelationalDatabase == nil {
+			return fmt.Errorf("Lightsail Database (%s) not found", rs.Primary.ID)
+		}
+
+		*res = *resp.RelationalDatabase
+
+		return nil
+	}
+}
+
+func testAccCheckAWSLightsailDatabaseDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lightsail_database" {
+			continue
+		}
+
+		resp, err := conn.GetRelationalDatabase(&lightsail.GetRelationalDatabaseInput{
+			RelationalDatabaseName: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if resp != nil && resp.RelationalDatabase != nil {
+			return fmt.Errorf("Lightsail Database (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSLightsailDatabaseConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {
+  state = "available"
+}
+
+resource "aws_lightsail_database" "test" {
+  name                 = %[1]q
+  availability_zone    = "${data.aws_availability_zones.available.names[0]}"
+  blueprint_id         = "mysql_5_7"
+  bundle_id            = "micro_1_0"
+  master_database_name = "testdatabase"
+  master_username      = "test"
+  master_password      = "testpassword"
+  skip_final_snapshot  = true
+}
+`, rName)
+}
+
+func testAccAWSLightsailDatabaseConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {
+  state = "available"
+}
+
+resource "aws_lightsail_database" "test" {
+  name                         = %[1]q
+  availability_zone            = "${data.aws_availability_zones.available.names[0]}"
+  blueprint_id                 = "mysql_5_7"
+  bundle_id                    = "micro_1_0"
+  master_database_name         = "testdatabase"
+  master_username              = "test"
+  master_password              = "testpassword2"
+  backup_retention_enabled     = false
+  publicly_accessible          = true
+  preferred_backup_window      = "09:30-10:00"
+  preferred_maintenance_window = "tue:10:00-tue:10:30"
+  apply_immediately            = true
+  skip_final_snapshot          = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
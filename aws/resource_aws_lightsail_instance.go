@@ -261,3 +261,24 @@ func resourceAwsLightsailOperationRefreshFunc(
 		return o, *o.Operation.Status, nil
 	}
 }
+
+// waitForLightsailOperations waits for all of the given Operations, returned
+// from Create/Update/Delete methods, to complete.
+func waitForLightsailOperations(meta interface{}, operations []*lightsail.Operation, timeout time.Duration) error {
+	for _, op := range operations {
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{lightsail.OperationStatusNotStarted, lightsail.OperationStatusStarted},
+			Target:     []string{lightsail.OperationStatusCompleted, lightsail.OperationStatusSucceeded},
+			Refresh:    resourceAwsLightsailOperationRefreshFunc(op.Id, meta),
+			Timeout:    timeout,
+			Delay:      5 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		if _, err := stateConf.WaitForState(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
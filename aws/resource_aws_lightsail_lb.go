@@ -0,0 +1,202 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsLightsailLb() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLightsailLbCreate,
+		Read:   resourceAwsLightsailLbRead,
+		Update: resourceAwsLightsailLbUpdate,
+		Delete: resourceAwsLightsailLbDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(0, 65535),
+			},
+			"health_check_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "/",
+			},
+			"tags": tagsSchema(),
+
+			// additional info returned from the API
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dns_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_ports": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"support_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLightsailLbCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	name := d.Get("name").(string)
+	input := &lightsail.CreateLoadBalancerInput{
+		HealthCheckPath:  aws.String(d.Get("health_check_path").(string)),
+		InstancePort:     aws.Int64(int64(d.Get("instance_port").(int))),
+		LoadBalancerName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().LightsailTags()
+	}
+
+	log.Printf("[DEBUG] Creating Lightsail Load Balancer: %s", input)
+	resp, err := conn.CreateLoadBalancer(input)
+	if err != nil {
+		return fmt.Errorf("error creating Lightsail Load Balancer (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if err := waitForLightsailOperations(meta, resp.Operations, 10*time.Minute); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Load Balancer (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsLightsailLbRead(d, meta)
+}
+
+func resourceAwsLightsailLbRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	resp, err := conn.GetLoadBalancer(&lightsail.GetLoadBalancerInput{
+		LoadBalancerName: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Lightsail Load Balancer (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Load Balancer (%s): %s", d.Id(), err)
+	}
+
+	if resp == nil || resp.LoadBalancer == nil {
+		log.Printf("[WARN] Lightsail Load Balancer (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	lb := resp.LoadBalancer
+
+	d.Set("name", lb.Name)
+	d.Set("arn", lb.Arn)
+	d.Set("instance_port", lb.InstancePort)
+	d.Set("health_check_path", lb.HealthCheckPath)
+	d.Set("dns_name", lb.DnsName)
+	d.Set("protocol", lb.Protocol)
+	d.Set("support_code", lb.SupportCode)
+
+	if lb.CreatedAt != nil {
+		d.Set("created_at", lb.CreatedAt.Format(time.RFC3339))
+	}
+
+	publicPorts := make([]interface{}, 0, len(lb.PublicPorts))
+	for _, port := range lb.PublicPorts {
+		publicPorts = append(publicPorts, int(aws.Int64Value(port)))
+	}
+
+	if err := d.Set("public_ports", publicPorts); err != nil {
+		return fmt.Errorf("error setting public_ports: %s", err)
+	}
+
+	if err := d.Set("tags", keyvaluetags.LightsailKeyValueTags(lb.Tags).IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsLightsailLbUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	if d.HasChange("health_check_path") {
+		resp, err := conn.UpdateLoadBalancerAttribute(&lightsail.UpdateLoadBalancerAttributeInput{
+			AttributeName:    aws.String(lightsail.LoadBalancerAttributeNameHealthCheckPath),
+			AttributeValue:   aws.String(d.Get("health_check_path").(string)),
+			LoadBalancerName: aws.String(d.Id()),
+		})
+		if err != nil {
+			return fmt.Errorf("error updating Lightsail Load Balancer (%s) health check path: %s", d.Id(), err)
+		}
+
+		if err := waitForLightsailOperations(meta, resp.Operations, 10*time.Minute); err != nil {
+			return fmt.Errorf("error waiting for Lightsail Load Balancer (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsLightsail(conn, d, d.Id()); err != nil {
+		return fmt.Errorf("error updating Lightsail Load Balancer (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsLightsailLbRead(d, meta)
+}
+
+func resourceAwsLightsailLbDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	log.Printf("[DEBUG] Deleting Lightsail Load Balancer: %s", d.Id())
+	resp, err := conn.DeleteLoadBalancer(&lightsail.DeleteLoadBalancerInput{
+		LoadBalancerName: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lightsail Load Balancer (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForLightsailOperations(meta, resp.Operations, 10*time.Minute); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Load Balancer (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
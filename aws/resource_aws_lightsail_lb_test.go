@@ -0,0 +1,185 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLightsailLb_basic(t *testing.T) {
+	var lb lightsail.LoadBalancer
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lightsail_lb.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailLbDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLightsailLbConfig_basic(rName, "/"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailLbExists(resourceName, &lb),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "instance_port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "health_check_path", "/"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "dns_name"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSLightsailLbConfig_basic(rName, "/healthcheck"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailLbExists(resourceName, &lb),
+					resource.TestCheckResourceAttr(resourceName, "health_check_path", "/healthcheck"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLightsailLb_Tags(t *testing.T) {
+	var lb lightsail.LoadBalancer
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lightsail_lb.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailLbDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLightsailLbConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailLbExists(resourceName, &lb),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSLightsailLbConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailLbExists(resourceName, &lb),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSLightsailLbConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailLbExists(resourceName, &lb),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLightsailLbExists(n string, res *lightsail.LoadBalancer) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lightsail Load Balancer ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+		resp, err := conn.GetLoadBalancer(&lightsail.GetLoadBalancerInput{
+			LoadBalancerName: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if resp == nil || resp.LoadBalancer == nil {
+			return fmt.Errorf("Lightsail Load Balancer (%s) not found", rs.Primary.ID)
+		}
+
+		*res = *resp.LoadBalancer
+
+		return nil
+	}
+}
+
+func testAccCheckAWSLightsailLbDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lightsail_lb" {
+			continue
+		}
+
+		resp, err := conn.GetLoadBalancer(&lightsail.GetLoadBalancerInput{
+			LoadBalancerName: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if resp != nil && resp.LoadBalancer != nil {
+			return fmt.Errorf("Lightsail Load Balancer (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSLightsailLbConfig_basic(rName, healthCheckPath string) string {
+	return fmt.Sprintf(`
+resource "aws_lightsail_lb" "test" {
+  name              = %[1]q
+  instance_port     = 80
+  health_check_path = %[2]q
+}
+`, rName, healthCheckPath)
+}
+
+func testAccAWSLightsailLbConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_lightsail_lb" "test" {
+  name          = %[1]q
+  instance_port = 80
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSLightsailLbConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_lightsail_lb" "test" {
+  name          = %[1]q
+  instance_port = 80
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,303 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsMediaConvertJobTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMediaConvertJobTemplateCreate,
+		Read:   resourceAwsMediaConvertJobTemplateRead,
+		Update: resourceAwsMediaConvertJobTemplateUpdate,
+		Delete: resourceAwsMediaConvertJobTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"acceleration_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								mediaconvert.AccelerationModeDisabled,
+								mediaconvert.AccelerationModeEnabled,
+							}, false),
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"category": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"queue": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateArn,
+			},
+			"settings": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateMediaConvertSettings(newMediaConvertJobTemplateSettings),
+				DiffSuppressFunc: suppressEquivalentMediaConvertSettings(newMediaConvertJobTemplateSettings),
+			},
+			"status_update_interval": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					mediaconvert.StatusUpdateIntervalSeconds10,
+					mediaconvert.StatusUpdateIntervalSeconds12,
+					mediaconvert.StatusUpdateIntervalSeconds15,
+					mediaconvert.StatusUpdateIntervalSeconds20,
+					mediaconvert.StatusUpdateIntervalSeconds30,
+					mediaconvert.StatusUpdateIntervalSeconds60,
+					mediaconvert.StatusUpdateIntervalSeconds120,
+					mediaconvert.StatusUpdateIntervalSeconds180,
+					mediaconvert.StatusUpdateIntervalSeconds240,
+					mediaconvert.StatusUpdateIntervalSeconds300,
+					mediaconvert.StatusUpdateIntervalSeconds360,
+					mediaconvert.StatusUpdateIntervalSeconds420,
+					mediaconvert.StatusUpdateIntervalSeconds480,
+					mediaconvert.StatusUpdateIntervalSeconds540,
+					mediaconvert.StatusUpdateIntervalSeconds600,
+				}, false),
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsMediaConvertJobTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn, err := getAwsMediaConvertAccountClient(meta.(*AWSClient))
+	if err != nil {
+		return fmt.Errorf("error getting MediaConvert account client: %s", err)
+	}
+
+	settings := &mediaconvert.JobTemplateSettings{}
+	if err := expandMediaConvertSettings(d.Get("settings").(string), settings); err != nil {
+		return fmt.Errorf("error expanding settings: %s", err)
+	}
+
+	input := &mediaconvert.CreateJobTemplateInput{
+		Name:     aws.String(d.Get("name").(string)),
+		Settings: settings,
+	}
+
+	if v, ok := d.GetOk("acceleration_settings"); ok {
+		input.AccelerationSettings = expandMediaConvertAccelerationSettings(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("category"); ok {
+		input.Category = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("queue"); ok {
+		input.Queue = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("status_update_interval"); ok {
+		input.StatusUpdateInterval = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = tagsFromMapGeneric(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating MediaConvert Job Template: %s", input)
+	output, err := conn.CreateJobTemplate(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MediaConvert Job Template: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.JobTemplate.Name))
+
+	return resourceAwsMediaConvertJobTemplateRead(d, meta)
+}
+
+func resourceAwsMediaConvertJobTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	conn, err := getAwsMediaConvertAccountClient(meta.(*AWSClient))
+	if err != nil {
+		return fmt.Errorf("error getting MediaConvert account client: %s", err)
+	}
+
+	output, err := conn.GetJobTemplate(&mediaconvert.GetJobTemplateInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, mediaconvert.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] MediaConvert Job Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MediaConvert Job Template (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.JobTemplate == nil {
+		return fmt.Errorf("error reading MediaConvert Job Template (%s): empty response", d.Id())
+	}
+
+	jobTemplate := output.JobTemplate
+	d.Set("arn", jobTemplate.Arn)
+	d.Set("category", jobTemplate.Category)
+	d.Set("description", jobTemplate.Description)
+	d.Set("name", jobTemplate.Name)
+	d.Set("queue", jobTemplate.Queue)
+	d.Set("status_update_interval", jobTemplate.StatusUpdateInterval)
+
+	if err := d.Set("acceleration_settings", flattenMediaConvertAccelerationSettings(jobTemplate.AccelerationSettings)); err != nil {
+		return fmt.Errorf("error setting acceleration_settings: %s", err)
+	}
+
+	settings, err := flattenMediaConvertSettings(jobTemplate.Settings)
+
+	if err != nil {
+		return fmt.Errorf("error flattening settings: %s", err)
+	}
+
+	d.Set("settings", settings)
+
+	tagsOutput, err := conn.ListTagsForResource(&mediaconvert.ListTagsForResourceInput{
+		Arn: jobTemplate.Arn,
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for MediaConvert Job Template (%s): %s", d.Id(), err)
+	}
+
+	var tags map[string]*string
+	if tagsOutput.ResourceTags != nil {
+		tags = tagsOutput.ResourceTags.Tags
+	}
+
+	if err := d.Set("tags", tagsToMapGeneric(tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMediaConvertJobTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn, err := getAwsMediaConvertAccountClient(meta.(*AWSClient))
+	if err != nil {
+		return fmt.Errorf("error getting MediaConvert account client: %s", err)
+	}
+
+	if d.HasChange("acceleration_settings") || d.HasChange("category") || d.HasChange("description") || d.HasChange("queue") || d.HasChange("settings") || d.HasChange("status_update_interval") {
+		settings := &mediaconvert.JobTemplateSettings{}
+		if err := expandMediaConvertSettings(d.Get("settings").(string), settings); err != nil {
+			return fmt.Errorf("error expanding settings: %s", err)
+		}
+
+		input := &mediaconvert.UpdateJobTemplateInput{
+			Name:                 aws.String(d.Id()),
+			AccelerationSettings: expandMediaConvertAccelerationSettings(d.Get("acceleration_settings").([]interface{})),
+			Category:             aws.String(d.Get("category").(string)),
+			Description:          aws.String(d.Get("description").(string)),
+			Settings:             settings,
+		}
+
+		if v, ok := d.GetOk("queue"); ok {
+			input.Queue = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("status_update_interval"); ok {
+			input.StatusUpdateInterval = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating MediaConvert Job Template: %s", input)
+		if _, err := conn.UpdateJobTemplate(input); err != nil {
+			return fmt.Errorf("error updating MediaConvert Job Template (%s): %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsMediaConvert(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating MediaConvert Job Template (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsMediaConvertJobTemplateRead(d, meta)
+}
+
+func resourceAwsMediaConvertJobTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	conn, err := getAwsMediaConvertAccountClient(meta.(*AWSClient))
+	if err != nil {
+		return fmt.Errorf("error getting MediaConvert account client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Deleting MediaConvert Job Template: %s", d.Id())
+	_, err = conn.DeleteJobTemplate(&mediaconvert.DeleteJobTemplateInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, mediaconvert.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MediaConvert Job Template (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func newMediaConvertJobTemplateSettings() interface{} {
+	return &mediaconvert.JobTemplateSettings{}
+}
+
+func expandMediaConvertAccelerationSettings(l []interface{}) *mediaconvert.AccelerationSettings {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &mediaconvert.AccelerationSettings{
+		Mode: aws.String(m["mode"].(string)),
+	}
+}
+
+func flattenMediaConvertAccelerationSettings(accelerationSettings *mediaconvert.AccelerationSettings) []interface{} {
+	if accelerationSettings == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"mode": aws.StringValue(accelerationSettings.Mode),
+	}
+
+	return []interface{}{m}
+}
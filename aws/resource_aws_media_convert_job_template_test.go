@@ -0,0 +1,240 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSMediaConvertJobTemplate_basic(t *testing.T) {
+	var jobTemplate mediaconvert.JobTemplate
+	resourceName := "aws_media_convert_job_template.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaConvert(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMediaConvertJobTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaConvertJobTemplateConfig_Basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaConvertJobTemplateExists(resourceName, &jobTemplate),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "mediaconvert", fmt.Sprintf("jobTemplates/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "category", ""),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "queue"),
+					resource.TestCheckResourceAttrSet(resourceName, "status_update_interval"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaConvertJobTemplate_withQueue(t *testing.T) {
+	var jobTemplate mediaconvert.JobTemplate
+	resourceName := "aws_media_convert_job_template.test"
+	queueResourceName := "aws_media_convert_queue.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaConvert(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMediaConvertJobTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaConvertJobTemplateConfig_withQueue(rName, "description1", mediaconvert.StatusUpdateIntervalSeconds30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaConvertJobTemplateExists(resourceName, &jobTemplate),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttrPair(resourceName, "queue", queueResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status_update_interval", mediaconvert.StatusUpdateIntervalSeconds30),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMediaConvertJobTemplateConfig_withQueue(rName, "description2", mediaconvert.StatusUpdateIntervalSeconds60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaConvertJobTemplateExists(resourceName, &jobTemplate),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "status_update_interval", mediaconvert.StatusUpdateIntervalSeconds60),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaConvertJobTemplate_withTags(t *testing.T) {
+	var jobTemplate mediaconvert.JobTemplate
+	resourceName := "aws_media_convert_job_template.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaConvert(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMediaConvertJobTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaConvertJobTemplateConfig_withTags(rName, "foo", "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaConvertJobTemplateExists(resourceName, &jobTemplate),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMediaConvertJobTemplateConfig_withTags(rName, "foo", "bar2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaConvertJobTemplateExists(resourceName, &jobTemplate),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsMediaConvertJobTemplateDestroy(s *terraform.State) error {
+	conn, err := getAwsMediaConvertAccountClient(testAccProvider.Meta().(*AWSClient))
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_media_convert_job_template" {
+			continue
+		}
+
+		_, err := conn.GetJobTemplate(&mediaconvert.GetJobTemplateInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, mediaconvert.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("MediaConvert Job Template (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsMediaConvertJobTemplateExists(resourceName string, jobTemplate *mediaconvert.JobTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn, err := getAwsMediaConvertAccountClient(testAccProvider.Meta().(*AWSClient))
+		if err != nil {
+			return err
+		}
+
+		output, err := conn.GetJobTemplate(&mediaconvert.GetJobTemplateInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*jobTemplate = *output.JobTemplate
+
+		return nil
+	}
+}
+
+func testAccMediaConvertJobTemplateConfigBase(rName string) string {
+	return testAccMediaConvertPresetConfig_Basic(rName, 96000) + fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+`, rName)
+}
+
+const testAccMediaConvertJobTemplateConfigSettings = `
+  settings = <<SETTINGS
+{
+  "outputGroups": [
+    {
+      "name": "File Group",
+      "outputGroupSettings": {
+        "type": "FILE_GROUP_SETTINGS",
+        "fileGroupSettings": {
+          "destination": "s3://${aws_s3_bucket.test.bucket}/"
+        }
+      },
+      "outputs": [
+        {
+          "preset": "${aws_media_convert_preset.test.name}"
+        }
+      ]
+    }
+  ]
+}
+SETTINGS
+`
+
+func testAccMediaConvertJobTemplateConfig_Basic(rName string) string {
+	return testAccMediaConvertJobTemplateConfigBase(rName) + fmt.Sprintf(`
+resource "aws_media_convert_job_template" "test" {
+  name = %[1]q
+%[2]s
+}
+`, rName, testAccMediaConvertJobTemplateConfigSettings)
+}
+
+func testAccMediaConvertJobTemplateConfig_withQueue(rName, description, statusUpdateInterval string) string {
+	return testAccMediaConvertJobTemplateConfigBase(rName) + fmt.Sprintf(`
+resource "aws_media_convert_queue" "test" {
+  name = %[1]q
+}
+
+resource "aws_media_convert_job_template" "test" {
+  name                   = %[1]q
+  description            = %[2]q
+  queue                  = "${aws_media_convert_queue.test.arn}"
+  status_update_interval = %[3]q
+%[4]s
+}
+`, rName, description, statusUpdateInterval, testAccMediaConvertJobTemplateConfigSettings)
+}
+
+func testAccMediaConvertJobTemplateConfig_withTags(rName, tagKey, tagValue string) string {
+	return testAccMediaConvertJobTemplateConfigBase(rName) + fmt.Sprintf(`
+resource "aws_media_convert_job_template" "test" {
+  name = %[1]q
+%[4]s
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey, tagValue, testAccMediaConvertJobTemplateConfigSettings)
+}
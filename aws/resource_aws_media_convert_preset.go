@@ -0,0 +1,205 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsMediaConvertPreset() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMediaConvertPresetCreate,
+		Read:   resourceAwsMediaConvertPresetRead,
+		Update: resourceAwsMediaConvertPresetUpdate,
+		Delete: resourceAwsMediaConvertPresetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"category": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"settings": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateMediaConvertSettings(newMediaConvertPresetSettings),
+				DiffSuppressFunc: suppressEquivalentMediaConvertSettings(newMediaConvertPresetSettings),
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsMediaConvertPresetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn, err := getAwsMediaConvertAccountClient(meta.(*AWSClient))
+	if err != nil {
+		return fmt.Errorf("error getting MediaConvert account client: %s", err)
+	}
+
+	settings := &mediaconvert.PresetSettings{}
+	if err := expandMediaConvertSettings(d.Get("settings").(string), settings); err != nil {
+		return fmt.Errorf("error expanding settings: %s", err)
+	}
+
+	input := &mediaconvert.CreatePresetInput{
+		Name:     aws.String(d.Get("name").(string)),
+		Settings: settings,
+	}
+
+	if v, ok := d.GetOk("category"); ok {
+		input.Category = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = tagsFromMapGeneric(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating MediaConvert Preset: %s", input)
+	output, err := conn.CreatePreset(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MediaConvert Preset: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Preset.Name))
+
+	return resourceAwsMediaConvertPresetRead(d, meta)
+}
+
+func resourceAwsMediaConvertPresetRead(d *schema.ResourceData, meta interface{}) error {
+	conn, err := getAwsMediaConvertAccountClient(meta.(*AWSClient))
+	if err != nil {
+		return fmt.Errorf("error getting MediaConvert account client: %s", err)
+	}
+
+	output, err := conn.GetPreset(&mediaconvert.GetPresetInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, mediaconvert.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] MediaConvert Preset (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MediaConvert Preset (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.Preset == nil {
+		return fmt.Errorf("error reading MediaConvert Preset (%s): empty response", d.Id())
+	}
+
+	preset := output.Preset
+	d.Set("arn", preset.Arn)
+	d.Set("category", preset.Category)
+	d.Set("description", preset.Description)
+	d.Set("name", preset.Name)
+
+	settings, err := flattenMediaConvertSettings(preset.Settings)
+
+	if err != nil {
+		return fmt.Errorf("error flattening settings: %s", err)
+	}
+
+	d.Set("settings", settings)
+
+	tagsOutput, err := conn.ListTagsForResource(&mediaconvert.ListTagsForResourceInput{
+		Arn: preset.Arn,
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for MediaConvert Preset (%s): %s", d.Id(), err)
+	}
+
+	var tags map[string]*string
+	if tagsOutput.ResourceTags != nil {
+		tags = tagsOutput.ResourceTags.Tags
+	}
+
+	if err := d.Set("tags", tagsToMapGeneric(tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMediaConvertPresetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn, err := getAwsMediaConvertAccountClient(meta.(*AWSClient))
+	if err != nil {
+		return fmt.Errorf("error getting MediaConvert account client: %s", err)
+	}
+
+	if d.HasChange("category") || d.HasChange("description") || d.HasChange("settings") {
+		settings := &mediaconvert.PresetSettings{}
+		if err := expandMediaConvertSettings(d.Get("settings").(string), settings); err != nil {
+			return fmt.Errorf("error expanding settings: %s", err)
+		}
+
+		input := &mediaconvert.UpdatePresetInput{
+			Name:        aws.String(d.Id()),
+			Category:    aws.String(d.Get("category").(string)),
+			Description: aws.String(d.Get("description").(string)),
+			Settings:    settings,
+		}
+
+		log.Printf("[DEBUG] Updating MediaConvert Preset: %s", input)
+		if _, err := conn.UpdatePreset(input); err != nil {
+			return fmt.Errorf("error updating MediaConvert Preset (%s): %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsMediaConvert(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating MediaConvert Preset (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsMediaConvertPresetRead(d, meta)
+}
+
+func resourceAwsMediaConvertPresetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn, err := getAwsMediaConvertAccountClient(meta.(*AWSClient))
+	if err != nil {
+		return fmt.Errorf("error getting MediaConvert account client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Deleting MediaConvert Preset: %s", d.Id())
+	_, err = conn.DeletePreset(&mediaconvert.DeletePresetInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, mediaconvert.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MediaConvert Preset (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func newMediaConvertPresetSettings() interface{} {
+	return &mediaconvert.PresetSettings{}
+}
@@ -0,0 +1,345 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestValidateMediaConvertPresetSettings(t *testing.T) {
+	var testCases = []struct {
+		settings    string
+		expectError bool
+	}{
+		{
+			settings:    `{"containerSettings":{"container":"MP4"}}`,
+			expectError: false,
+		},
+		{
+			settings:    `{"audioDescriptions":[{"codecSettings":{"codec":"AAC","aacSettings":{"bitrate":96000,"sampleRate":48000}}}]}`,
+			expectError: false,
+		},
+		{
+			settings:    `{"containerSettings":{"container":"MP4"}`,
+			expectError: true,
+		},
+		{
+			settings:    `{"containerSetting":{"container":"MP4"}}`,
+			expectError: true,
+		},
+		{
+			settings:    `{"containerSettings":{"container":"MP4","unknown":true}}`,
+			expectError: true,
+		},
+	}
+
+	validate := validateMediaConvertSettings(newMediaConvertPresetSettings)
+
+	for i, tc := range testCases {
+		_, errors := validate(tc.settings, "settings")
+		if tc.expectError && len(errors) == 0 {
+			t.Fatalf("Test Case %d: expected error for settings: %s", i, tc.settings)
+		}
+		if !tc.expectError && len(errors) > 0 {
+			t.Fatalf("Test Case %d: unexpected errors for settings %s: %v", i, tc.settings, errors)
+		}
+	}
+}
+
+func TestSuppressEquivalentMediaConvertPresetSettings(t *testing.T) {
+	var testCases = []struct {
+		old        string
+		new        string
+		equivalent bool
+	}{
+		{
+			old:        `{"containerSettings":{"container":"MP4","mp4Settings":{"moovPlacement":"NORMAL"}}}`,
+			new:        `{"containerSettings": {"mp4Settings": {"moovPlacement": "NORMAL"}, "container": "MP4"}}`,
+			equivalent: true,
+		},
+		{
+			old:        `{"containerSettings":{"container":"MP4"}}`,
+			new:        `{"containerSettings":{"container":"MOV"}}`,
+			equivalent: false,
+		},
+		{
+			old:        `{"containerSettings":{"container":"MP4"}}`,
+			new:        `{"containerSettings":{"container":"MP4","mp4Settings":{"moovPlacement":"NORMAL"}}}`,
+			equivalent: false,
+		},
+		{
+			old:        ``,
+			new:        `{"containerSettings":{"container":"MP4"}}`,
+			equivalent: false,
+		},
+	}
+
+	suppress := suppressEquivalentMediaConvertSettings(newMediaConvertPresetSettings)
+
+	for i, tc := range testCases {
+		actual := suppress("settings", tc.old, tc.new, nil)
+		if actual != tc.equivalent {
+			t.Fatalf("Test Case %d: Got: %t Expected: %t", i, actual, tc.equivalent)
+		}
+	}
+}
+
+func TestAccAWSMediaConvertPreset_basic(t *testing.T) {
+	var preset mediaconvert.Preset
+	resourceName := "aws_media_convert_preset.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaConvert(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMediaConvertPresetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaConvertPresetConfig_Basic(rName, 96000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaConvertPresetExists(resourceName, &preset),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "mediaconvert", fmt.Sprintf("presets/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "category", ""),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMediaConvertPresetConfig_Basic(rName, 128000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaConvertPresetExists(resourceName, &preset),
+					testAccCheckAwsMediaConvertPresetAacBitrate(&preset, 128000),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaConvertPreset_withDescription(t *testing.T) {
+	var preset mediaconvert.Preset
+	resourceName := "aws_media_convert_preset.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaConvert(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMediaConvertPresetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaConvertPresetConfig_withDescription(rName, "category1", "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaConvertPresetExists(resourceName, &preset),
+					resource.TestCheckResourceAttr(resourceName, "category", "category1"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMediaConvertPresetConfig_withDescription(rName, "category2", "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaConvertPresetExists(resourceName, &preset),
+					resource.TestCheckResourceAttr(resourceName, "category", "category2"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaConvertPreset_withTags(t *testing.T) {
+	var preset mediaconvert.Preset
+	resourceName := "aws_media_convert_preset.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaConvert(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMediaConvertPresetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaConvertPresetConfig_withTags(rName, "foo", "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaConvertPresetExists(resourceName, &preset),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMediaConvertPresetConfig_withTags(rName, "foo", "bar2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaConvertPresetExists(resourceName, &preset),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsMediaConvertPresetDestroy(s *terraform.State) error {
+	conn, err := getAwsMediaConvertAccountClient(testAccProvider.Meta().(*AWSClient))
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_media_convert_preset" {
+			continue
+		}
+
+		_, err := conn.GetPreset(&mediaconvert.GetPresetInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, mediaconvert.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("MediaConvert Preset (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsMediaConvertPresetExists(resourceName string, preset *mediaconvert.Preset) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn, err := getAwsMediaConvertAccountClient(testAccProvider.Meta().(*AWSClient))
+		if err != nil {
+			return err
+		}
+
+		output, err := conn.GetPreset(&mediaconvert.GetPresetInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*preset = *output.Preset
+
+		return nil
+	}
+}
+
+func testAccCheckAwsMediaConvertPresetAacBitrate(preset *mediaconvert.Preset, expected int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if preset.Settings == nil || len(preset.Settings.AudioDescriptions) == 0 {
+			return fmt.Errorf("MediaConvert Preset (%s) has no audio descriptions", aws.StringValue(preset.Name))
+		}
+
+		codecSettings := preset.Settings.AudioDescriptions[0].CodecSettings
+		if codecSettings == nil || codecSettings.AacSettings == nil {
+			return fmt.Errorf("MediaConvert Preset (%s) has no AAC settings", aws.StringValue(preset.Name))
+		}
+
+		if actual := aws.Int64Value(codecSettings.AacSettings.Bitrate); actual != expected {
+			return fmt.Errorf("MediaConvert Preset (%s) AAC bitrate: expected %d, got %d", aws.StringValue(preset.Name), expected, actual)
+		}
+
+		return nil
+	}
+}
+
+func testAccMediaConvertPresetConfig_settings(bitrate int) string {
+	return fmt.Sprintf(`{
+  "containerSettings": {
+    "container": "MP4",
+    "mp4Settings": {
+      "cslgAtom": "INCLUDE",
+      "freeSpaceBox": "EXCLUDE",
+      "moovPlacement": "PROGRESSIVE_DOWNLOAD"
+    }
+  },
+  "audioDescriptions": [
+    {
+      "audioTypeControl": "FOLLOW_INPUT",
+      "languageCodeControl": "FOLLOW_INPUT",
+      "codecSettings": {
+        "codec": "AAC",
+        "aacSettings": {
+          "audioDescriptionBroadcasterMix": "NORMAL",
+          "bitrate": %[1]d,
+          "codecProfile": "LC",
+          "codingMode": "CODING_MODE_2_0",
+          "rateControlMode": "CBR",
+          "rawFormat": "NONE",
+          "sampleRate": 48000,
+          "specification": "MPEG4"
+        }
+      }
+    }
+  ]
+}`, bitrate)
+}
+
+func testAccMediaConvertPresetConfig_Basic(rName string, bitrate int) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_preset" "test" {
+  name = %[1]q
+
+  settings = <<SETTINGS
+%[2]s
+SETTINGS
+}
+`, rName, testAccMediaConvertPresetConfig_settings(bitrate))
+}
+
+func testAccMediaConvertPresetConfig_withDescription(rName, category, description string) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_preset" "test" {
+  name        = %[1]q
+  category    = %[2]q
+  description = %[3]q
+
+  settings = <<SETTINGS
+%[4]s
+SETTINGS
+}
+`, rName, category, description, testAccMediaConvertPresetConfig_settings(96000))
+}
+
+func testAccMediaConvertPresetConfig_withTags(rName, tagKey, tagValue string) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_preset" "test" {
+  name = %[1]q
+
+  settings = <<SETTINGS
+%[4]s
+SETTINGS
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey, tagValue, testAccMediaConvertPresetConfig_settings(96000))
+}
@@ -0,0 +1,328 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsMediaConvertQueue() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMediaConvertQueueCreate,
+		Read:   resourceAwsMediaConvertQueueRead,
+		Update: resourceAwsMediaConvertQueueUpdate,
+		Delete: resourceAwsMediaConvertQueueDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"pricing_plan": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  mediaconvert.PricingPlanOnDemand,
+				ValidateFunc: validation.StringInSlice([]string{
+					mediaconvert.PricingPlanOnDemand,
+					mediaconvert.PricingPlanReserved,
+				}, false),
+			},
+			"reservation_plan_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"commitment": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								mediaconvert.CommitmentOneYear,
+							}, false),
+						},
+						"renewal_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								mediaconvert.RenewalTypeAutoRenew,
+								mediaconvert.RenewalTypeExpire,
+							}, false),
+						},
+						"reserved_slots": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  mediaconvert.QueueStatusActive,
+				ValidateFunc: validation.StringInSlice([]string{
+					mediaconvert.QueueStatusActive,
+					mediaconvert.QueueStatusPaused,
+				}, false),
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsMediaConvertQueueCreate(d *schema.ResourceData, meta interface{}) error {
+	conn, err := getAwsMediaConvertAccountClient(meta.(*AWSClient))
+	if err != nil {
+		return fmt.Errorf("error getting MediaConvert account client: %s", err)
+	}
+
+	input := &mediaconvert.CreateQueueInput{
+		Name:        aws.String(d.Get("name").(string)),
+		PricingPlan: aws.String(d.Get("pricing_plan").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("reservation_plan_settings"); ok {
+		input.ReservationPlanSettings = expandMediaConvertReservationPlanSettings(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = tagsFromMapGeneric(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating MediaConvert Queue: %s", input)
+	output, err := conn.CreateQueue(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MediaConvert Queue: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Queue.Name))
+
+	// Queues are always created active.
+	if v := d.Get("status").(string); v != mediaconvert.QueueStatusActive {
+		_, err := conn.UpdateQueue(&mediaconvert.UpdateQueueInput{
+			Name:   aws.String(d.Id()),
+			Status: aws.String(v),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error updating MediaConvert Queue (%s) status: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMediaConvertQueueRead(d, meta)
+}
+
+func resourceAwsMediaConvertQueueRead(d *schema.ResourceData, meta interface{}) error {
+	conn, err := getAwsMediaConvertAccountClient(meta.(*AWSClient))
+	if err != nil {
+		return fmt.Errorf("error getting MediaConvert account client: %s", err)
+	}
+
+	output, err := conn.GetQueue(&mediaconvert.GetQueueInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, mediaconvert.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] MediaConvert Queue (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MediaConvert Queue (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.Queue == nil {
+		return fmt.Errorf("error reading MediaConvert Queue (%s): empty response", d.Id())
+	}
+
+	queue := output.Queue
+	d.Set("arn", queue.Arn)
+	d.Set("description", queue.Description)
+	d.Set("name", queue.Name)
+	d.Set("pricing_plan", queue.PricingPlan)
+	d.Set("status", queue.Status)
+
+	if err := d.Set("reservation_plan_settings", flattenMediaConvertReservationPlan(queue.ReservationPlan)); err != nil {
+		return fmt.Errorf("error setting reservation_plan_settings: %s", err)
+	}
+
+	tagsOutput, err := conn.ListTagsForResource(&mediaconvert.ListTagsForResourceInput{
+		Arn: queue.Arn,
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for MediaConvert Queue (%s): %s", d.Id(), err)
+	}
+
+	var tags map[string]*string
+	if tagsOutput.ResourceTags != nil {
+		tags = tagsOutput.ResourceTags.Tags
+	}
+
+	if err := d.Set("tags", tagsToMapGeneric(tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMediaConvertQueueUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn, err := getAwsMediaConvertAccountClient(meta.(*AWSClient))
+	if err != nil {
+		return fmt.Errorf("error getting MediaConvert account client: %s", err)
+	}
+
+	if d.HasChange("description") || d.HasChange("reservation_plan_settings") || d.HasChange("status") {
+		input := &mediaconvert.UpdateQueueInput{
+			Name:        aws.String(d.Id()),
+			Description: aws.String(d.Get("description").(string)),
+			Status:      aws.String(d.Get("status").(string)),
+		}
+
+		// Reserved slots can only be increased, which purchases an additional
+		// 12 month commitment for the larger number.
+		if d.HasChange("reservation_plan_settings") {
+			input.ReservationPlanSettings = expandMediaConvertReservationPlanSettings(d.Get("reservation_plan_settings").([]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating MediaConvert Queue: %s", input)
+		if _, err := conn.UpdateQueue(input); err != nil {
+			return fmt.Errorf("error updating MediaConvert Queue (%s): %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsMediaConvert(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating MediaConvert Queue (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsMediaConvertQueueRead(d, meta)
+}
+
+func resourceAwsMediaConvertQueueDelete(d *schema.ResourceData, meta interface{}) error {
+	conn, err := getAwsMediaConvertAccountClient(meta.(*AWSClient))
+	if err != nil {
+		return fmt.Errorf("error getting MediaConvert account client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Deleting MediaConvert Queue: %s", d.Id())
+	_, err = conn.DeleteQueue(&mediaconvert.DeleteQueueInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, mediaconvert.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MediaConvert Queue (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// getAwsMediaConvertAccountClient returns a MediaConvert client for the
+// account-specific endpoint, which every API call other than
+// DescribeEndpoints must be sent to. The endpoint is looked up once and the
+// client cached for the lifetime of the provider.
+func getAwsMediaConvertAccountClient(awsClient *AWSClient) (*mediaconvert.MediaConvert, error) {
+	const mutexKey = `mediaconvertaccountconn`
+	awsMutexKV.Lock(mutexKey)
+	defer awsMutexKV.Unlock(mutexKey)
+
+	if awsClient.mediaconvertaccountconn != nil {
+		return awsClient.mediaconvertaccountconn, nil
+	}
+
+	output, err := awsClient.mediaconvertconn.DescribeEndpoints(&mediaconvert.DescribeEndpointsInput{
+		Mode: aws.String(mediaconvert.DescribeEndpointsModeDefault),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error describing MediaConvert Endpoints: %s", err)
+	}
+
+	if output == nil || len(output.Endpoints) == 0 || output.Endpoints[0] == nil {
+		return nil, fmt.Errorf("error describing MediaConvert Endpoints: empty response")
+	}
+
+	conn, err := newMediaConvertAccountClient(awsClient.mediaconvertconn, aws.StringValue(output.Endpoints[0].Url))
+
+	if err != nil {
+		return nil, err
+	}
+
+	awsClient.mediaconvertaccountconn = conn
+
+	return conn, nil
+}
+
+// newMediaConvertAccountClient returns a copy of the provider MediaConvert
+// client pointed at the account specific endpoint. The handlers and retryer are
+// carried over so that the user agent, request logging and service_limits
+// configuration also apply to the account endpoint.
+func newMediaConvertAccountClient(conn *mediaconvert.MediaConvert, endpoint string) (*mediaconvert.MediaConvert, error) {
+	sess, err := session.NewSession(&conn.Config)
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating AWS MediaConvert session: %s", err)
+	}
+
+	accountConn := mediaconvert.New(sess.Copy(&aws.Config{Endpoint: aws.String(endpoint)}))
+	accountConn.Handlers = conn.Handlers.Copy()
+	accountConn.Retryer = conn.Retryer
+
+	return accountConn, nil
+}
+
+func expandMediaConvertReservationPlanSettings(l []interface{}) *mediaconvert.ReservationPlanSettings {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &mediaconvert.ReservationPlanSettings{
+		Commitment:    aws.String(m["commitment"].(string)),
+		RenewalType:   aws.String(m["renewal_type"].(string)),
+		ReservedSlots: aws.Int64(int64(m["reserved_slots"].(int))),
+	}
+}
+
+func flattenMediaConvertReservationPlan(reservationPlan *mediaconvert.ReservationPlan) []interface{} {
+	if reservationPlan == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"commitment":     aws.StringValue(reservationPlan.Commitment),
+		"renewal_type":   aws.StringValue(reservationPlan.RenewalType),
+		"reserved_slots": int(aws.Int64Value(reservationPlan.ReservedSlots)),
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,248 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestNewMediaConvertAccountClient(t *testing.T) {
+	client := testServiceLimitsAWSClient(t)
+
+	err := client.configureServiceLimits(retryModeStandard, map[string]*ServiceLimit{
+		"mediaconvert": {MaxRetries: aws.Int(2), RequestsPerSecond: 5},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	endpoint := "https://abcd1234.mediaconvert.us-east-1.amazonaws.com"
+	conn, err := newMediaConvertAccountClient(client.mediaconvertconn, endpoint)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if conn.Endpoint != endpoint {
+		t.Errorf("got endpoint %q, expected %q", conn.Endpoint, endpoint)
+	}
+
+	if retries := conn.MaxRetries(); retries != 2 {
+		t.Errorf("got retryer max retries %d, expected 2", retries)
+	}
+
+	sendHandlers := conn.Handlers.Send.Len()
+	conn.Handlers.Send.RemoveByName("terraform-provider-aws.RequestRateLimiter")
+
+	if conn.Handlers.Send.Len() != sendHandlers-1 {
+		t.Errorf("expected the service_limits rate limiter to be carried over to the account endpoint client")
+	}
+
+	if client.mediaconvertconn.Handlers.Send.Len() != sendHandlers {
+		t.Errorf("expected the provider client handlers to be copied, not shared")
+	}
+}
+
+func TestAccAWSMediaConvertQueue_basic(t *testing.T) {
+	var queue mediaconvert.Queue
+	resourceName := "aws_media_convert_queue.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaConvert(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMediaConvertQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaConvertQueueConfig_Basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaConvertQueueExists(resourceName, &queue),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "mediaconvert", fmt.Sprintf("queues/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "pricing_plan", mediaconvert.PricingPlanOnDemand),
+					resource.TestCheckResourceAttr(resourceName, "reservation_plan_settings.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "status", mediaconvert.QueueStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaConvertQueue_withStatus(t *testing.T) {
+	var queue mediaconvert.Queue
+	resourceName := "aws_media_convert_queue.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaConvert(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMediaConvertQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaConvertQueueConfig_withStatus(rName, "description1", mediaconvert.QueueStatusPaused),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaConvertQueueExists(resourceName, &queue),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "status", mediaconvert.QueueStatusPaused),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMediaConvertQueueConfig_withStatus(rName, "description2", mediaconvert.QueueStatusActive),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaConvertQueueExists(resourceName, &queue),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "status", mediaconvert.QueueStatusActive),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaConvertQueue_withTags(t *testing.T) {
+	var queue mediaconvert.Queue
+	resourceName := "aws_media_convert_queue.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaConvert(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMediaConvertQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaConvertQueueConfig_withTags(rName, "foo", "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaConvertQueueExists(resourceName, &queue),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMediaConvertQueueConfig_withTags(rName, "foo", "bar2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaConvertQueueExists(resourceName, &queue),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsMediaConvertQueueDestroy(s *terraform.State) error {
+	conn, err := getAwsMediaConvertAccountClient(testAccProvider.Meta().(*AWSClient))
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_media_convert_queue" {
+			continue
+		}
+
+		_, err := conn.GetQueue(&mediaconvert.GetQueueInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, mediaconvert.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("MediaConvert Queue (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsMediaConvertQueueExists(resourceName string, queue *mediaconvert.Queue) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn, err := getAwsMediaConvertAccountClient(testAccProvider.Meta().(*AWSClient))
+		if err != nil {
+			return err
+		}
+
+		output, err := conn.GetQueue(&mediaconvert.GetQueueInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*queue = *output.Queue
+
+		return nil
+	}
+}
+
+func testAccPreCheckAWSMediaConvert(t *testing.T) {
+	_, err := getAwsMediaConvertAccountClient(testAccProvider.Meta().(*AWSClient))
+
+	if testAccPreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccMediaConvertQueueConfig_Basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_queue" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccMediaConvertQueueConfig_withStatus(rName, description, status string) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_queue" "test" {
+  name        = %[1]q
+  description = %[2]q
+  status      = %[3]q
+}
+`, rName, description, status)
+}
+
+func testAccMediaConvertQueueConfig_withTags(rName, tagKey, tagValue string) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_queue" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey, tagValue)
+}
@@ -32,7 +32,6 @@ func resourceAwsMqBroker() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
-				ForceNew: true,
 			},
 			"broker_name": {
 				Type:     schema.TypeString,
@@ -73,7 +72,10 @@ func resourceAwsMqBroker() *schema.Resource {
 			"engine_version": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
+			},
+			"pending_engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			"host_instance_type": {
 				Type:     schema.TypeString,
@@ -306,6 +308,12 @@ func resourceAwsMqBrokerRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("deployment_mode", out.DeploymentMode)
 	d.Set("engine_type", out.EngineType)
 	d.Set("engine_version", out.EngineVersion)
+	d.Set("pending_engine_version", out.PendingEngineVersion)
+	// An engine version upgrade is only applied on the next reboot or
+	// maintenance window, until then report the requested version.
+	if out.PendingEngineVersion != nil {
+		d.Set("engine_version", out.PendingEngineVersion)
+	}
 	d.Set("host_instance_type", out.HostInstanceType)
 	d.Set("publicly_accessible", out.PubliclyAccessible)
 	err = d.Set("maintenance_window_start_time", flattenMqWeeklyStartTime(out.MaintenanceWindowStartTime))
@@ -352,14 +360,24 @@ func resourceAwsMqBrokerRead(d *schema.ResourceData, meta interface{}) error {
 func resourceAwsMqBrokerUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).mqconn
 
-	if d.HasChange("configuration") || d.HasChange("logs") {
-		_, err := conn.UpdateBroker(&mq.UpdateBrokerRequest{
+	if d.HasChange("configuration") || d.HasChange("logs") || d.HasChange("engine_version") || d.HasChange("auto_minor_version_upgrade") {
+		input := &mq.UpdateBrokerRequest{
 			BrokerId:      aws.String(d.Id()),
 			Configuration: expandMqConfigurationId(d.Get("configuration").([]interface{})),
 			Logs:          expandMqLogs(d.Get("logs").([]interface{})),
-		})
+		}
+
+		if d.HasChange("engine_version") {
+			input.EngineVersion = aws.String(d.Get("engine_version").(string))
+		}
+
+		if d.HasChange("auto_minor_version_upgrade") {
+			input.AutoMinorVersionUpgrade = aws.Bool(d.Get("auto_minor_version_upgrade").(bool))
+		}
+
+		_, err := conn.UpdateBroker(input)
 		if err != nil {
-			return err
+			return fmt.Errorf("error updating MQ Broker (%s): %s", d.Id(), err)
 		}
 	}
 
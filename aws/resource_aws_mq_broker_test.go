@@ -560,6 +560,37 @@ func TestAccAWSMqBroker_updateUsers(t *testing.T) {
 	})
 }
 
+func TestAccAWSMqBroker_updateEngineVersion(t *testing.T) {
+	sgName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	brokerName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMqBrokerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMqBrokerConfig_engineVersion(sgName, brokerName, "5.15.0", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMqBrokerExists("aws_mq_broker.test"),
+					resource.TestCheckResourceAttr("aws_mq_broker.test", "engine_version", "5.15.0"),
+					resource.TestCheckResourceAttr("aws_mq_broker.test", "auto_minor_version_upgrade", "false"),
+					resource.TestCheckResourceAttr("aws_mq_broker.test", "pending_engine_version", ""),
+				),
+			},
+			{
+				Config: testAccMqBrokerConfig_engineVersion(sgName, brokerName, "5.15.9", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMqBrokerExists("aws_mq_broker.test"),
+					resource.TestCheckResourceAttr("aws_mq_broker.test", "engine_version", "5.15.9"),
+					resource.TestCheckResourceAttr("aws_mq_broker.test", "auto_minor_version_upgrade", "true"),
+					resource.TestCheckResourceAttr("aws_mq_broker.test", "pending_engine_version", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSMqBroker_updateTags(t *testing.T) {
 	sgName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
 	brokerName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
@@ -659,6 +690,28 @@ resource "aws_mq_broker" "test" {
 }`, sgName, brokerName)
 }
 
+func testAccMqBrokerConfig_engineVersion(sgName, brokerName, engineVersion string, autoMinorVersionUpgrade bool) string {
+	return fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name = "%s"
+}
+
+resource "aws_mq_broker" "test" {
+  broker_name                = "%s"
+  apply_immediately          = true
+  auto_minor_version_upgrade = %t
+  engine_type                = "ActiveMQ"
+  engine_version             = "%s"
+  host_instance_type         = "mq.t2.micro"
+  security_groups            = ["${aws_security_group.test.id}"]
+
+  user {
+    username = "Test"
+    password = "TestTest1234"
+  }
+}`, sgName, brokerName, autoMinorVersionUpgrade, engineVersion)
+}
+
 func testAccMqBrokerConfig_allFieldsDefaultVpc(sgName, cfgName, cfgBody, brokerName string) string {
 	return fmt.Sprintf(`
 resource "aws_security_group" "mq1" {
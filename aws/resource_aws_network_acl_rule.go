@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -19,6 +20,29 @@ func resourceAwsNetworkAclRule() *schema.Resource {
 		Create: resourceAwsNetworkAclRuleCreate,
 		Read:   resourceAwsNetworkAclRuleRead,
 		Delete: resourceAwsNetworkAclRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idParts := strings.Split(d.Id(), ":")
+				if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
+					return nil, fmt.Errorf("unexpected format of ID (%q), expected NETWORK_ACL_ID:RULE_NUMBER:PROTOCOL:EGRESS", d.Id())
+				}
+				networkAclID := idParts[0]
+				ruleNumber, err := strconv.Atoi(idParts[1])
+				if err != nil {
+					return nil, fmt.Errorf("unexpected rule number in ID (%q): %s", d.Id(), err)
+				}
+				protocol := idParts[2]
+				egress, err := strconv.ParseBool(idParts[3])
+				if err != nil {
+					return nil, fmt.Errorf("unexpected egress flag in ID (%q): %s", d.Id(), err)
+				}
+				d.Set("network_acl_id", networkAclID)
+				d.Set("rule_number", ruleNumber)
+				d.Set("egress", egress)
+				d.SetId(networkAclIdRuleNumberEgressHash(networkAclID, ruleNumber, egress, protocol))
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"network_acl_id": {
@@ -30,6 +30,12 @@ func TestAccAWSNetworkAclRule_basic(t *testing.T) {
 					testAccCheckAWSNetworkAclRuleExists("aws_network_acl_rule.wibble", &networkAcl),
 				),
 			},
+			{
+				ResourceName:      "aws_network_acl_rule.baz",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSNetworkAclRuleImportStateIdFunc("aws_network_acl_rule.baz"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -336,6 +342,17 @@ func testAccCheckAWSNetworkAclRuleDelete(n string) resource.TestCheckFunc {
 	}
 }
 
+func testAccAWSNetworkAclRuleImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s:%s:%s:%s", rs.Primary.Attributes["network_acl_id"], rs.Primary.Attributes["rule_number"], rs.Primary.Attributes["protocol"], rs.Primary.Attributes["egress"]), nil
+	}
+}
+
 const testAccAWSNetworkAclRuleBasicConfig = `
 resource "aws_vpc" "foo" {
 	cidr_block = "10.3.0.0/16"
@@ -112,7 +112,10 @@ func resourceAwsOrganizationsOrganization() *schema.Resource {
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 					ValidateFunc: validation.StringInSlice([]string{
+						organizationsPolicyTypeAiservicesOptOutPolicy,
+						organizationsPolicyTypeBackupPolicy,
 						organizations.PolicyTypeServiceControlPolicy,
+						organizationsPolicyTypeTagPolicy,
 					}, false),
 				},
 			},
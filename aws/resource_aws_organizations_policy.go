@@ -12,6 +12,13 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 )
 
+// Policy types that are not yet modeled in the vendored AWS SDK
+const (
+	organizationsPolicyTypeAiservicesOptOutPolicy = "AISERVICES_OPT_OUT_POLICY"
+	organizationsPolicyTypeBackupPolicy           = "BACKUP_POLICY"
+	organizationsPolicyTypeTagPolicy              = "TAG_POLICY"
+)
+
 func resourceAwsOrganizationsPolicy() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsOrganizationsPolicyCreate,
@@ -30,7 +37,7 @@ func resourceAwsOrganizationsPolicy() *schema.Resource {
 			"content": {
 				Type:             schema.TypeString,
 				Required:         true,
-				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+				DiffSuppressFunc: suppressOrganizationsPolicyContentDiffs,
 				ValidateFunc:     validation.ValidateJsonString,
 			},
 			"description": {
@@ -47,7 +54,10 @@ func resourceAwsOrganizationsPolicy() *schema.Resource {
 				ForceNew: true,
 				Default:  organizations.PolicyTypeServiceControlPolicy,
 				ValidateFunc: validation.StringInSlice([]string{
+					organizationsPolicyTypeAiservicesOptOutPolicy,
+					organizationsPolicyTypeBackupPolicy,
 					organizations.PolicyTypeServiceControlPolicy,
+					organizationsPolicyTypeTagPolicy,
 				}, false),
 			},
 		},
@@ -172,3 +182,13 @@ func resourceAwsOrganizationsPolicyDelete(d *schema.ResourceData, meta interface
 	}
 	return nil
 }
+
+// suppressOrganizationsPolicyContentDiffs compares service control policies
+// as IAM policy documents and all other (management) policy types as plain JSON.
+func suppressOrganizationsPolicyContentDiffs(k, old, new string, d *schema.ResourceData) bool {
+	if d.Get("type").(string) == organizations.PolicyTypeServiceControlPolicy {
+		return suppressEquivalentAwsPolicyDiffs(k, old, new, d)
+	}
+
+	return suppressEquivalentJsonDiffs(k, old, new, d)
+}
@@ -81,26 +81,28 @@ func resourceAwsOrganizationsPolicyAttachmentRead(d *schema.ResourceData, meta i
 		return err
 	}
 
-	input := &organizations.ListPoliciesForTargetInput{
-		Filter:   aws.String(organizations.PolicyTypeServiceControlPolicy),
-		TargetId: aws.String(targetID),
+	// Listing targets for the policy rather than policies for the target
+	// avoids having to know the policy type, which ListPoliciesForTarget
+	// requires as a filter.
+	input := &organizations.ListTargetsForPolicyInput{
+		PolicyId: aws.String(policyID),
 	}
 
-	log.Printf("[DEBUG] Listing Organizations Policies for Target: %s", input)
-	var output *organizations.PolicySummary
-	err = conn.ListPoliciesForTargetPages(input, func(page *organizations.ListPoliciesForTargetOutput, lastPage bool) bool {
-		for _, policySummary := range page.Policies {
-			if aws.StringValue(policySummary.Id) == policyID {
-				output = policySummary
-				return true
+	log.Printf("[DEBUG] Listing Organizations Policy Targets: %s", input)
+	var output *organizations.PolicyTargetSummary
+	err = conn.ListTargetsForPolicyPages(input, func(page *organizations.ListTargetsForPolicyOutput, lastPage bool) bool {
+		for _, targetSummary := range page.Targets {
+			if aws.StringValue(targetSummary.TargetId) == targetID {
+				output = targetSummary
+				return false
 			}
 		}
 		return !lastPage
 	})
 
 	if err != nil {
-		if isAWSErr(err, organizations.ErrCodeTargetNotFoundException, "") {
-			log.Printf("[WARN] Target does not exist, removing from state: %s", d.Id())
+		if isAWSErr(err, organizations.ErrCodePolicyNotFoundException, "") {
+			log.Printf("[WARN] Policy does not exist, removing from state: %s", d.Id())
 			d.SetId("")
 			return nil
 		}
@@ -109,18 +109,17 @@ func testAccCheckAwsOrganizationsPolicyAttachmentDestroy(s *terraform.State) err
 			return err
 		}
 
-		input := &organizations.ListPoliciesForTargetInput{
-			Filter:   aws.String(organizations.PolicyTypeServiceControlPolicy),
-			TargetId: aws.String(targetID),
+		input := &organizations.ListTargetsForPolicyInput{
+			PolicyId: aws.String(policyID),
 		}
 
-		log.Printf("[DEBUG] Listing Organizations Policies for Target: %s", input)
-		var output *organizations.PolicySummary
-		err = conn.ListPoliciesForTargetPages(input, func(page *organizations.ListPoliciesForTargetOutput, lastPage bool) bool {
-			for _, policySummary := range page.Policies {
-				if aws.StringValue(policySummary.Id) == policyID {
-					output = policySummary
-					return true
+		log.Printf("[DEBUG] Listing Organizations Policy Targets: %s", input)
+		var output *organizations.PolicyTargetSummary
+		err = conn.ListTargetsForPolicyPages(input, func(page *organizations.ListTargetsForPolicyOutput, lastPage bool) bool {
+			for _, targetSummary := range page.Targets {
+				if aws.StringValue(targetSummary.TargetId) == targetID {
+					output = targetSummary
+					return false
 				}
 			}
 			return !lastPage
@@ -130,7 +129,7 @@ func testAccCheckAwsOrganizationsPolicyAttachmentDestroy(s *terraform.State) err
 			continue
 		}
 
-		if isAWSErr(err, organizations.ErrCodeTargetNotFoundException, "") {
+		if isAWSErr(err, organizations.ErrCodePolicyNotFoundException, "") {
 			continue
 		}
 
@@ -163,18 +162,17 @@ func testAccCheckAwsOrganizationsPolicyAttachmentExists(resourceName string) res
 			return err
 		}
 
-		input := &organizations.ListPoliciesForTargetInput{
-			Filter:   aws.String(organizations.PolicyTypeServiceControlPolicy),
-			TargetId: aws.String(targetID),
+		input := &organizations.ListTargetsForPolicyInput{
+			PolicyId: aws.String(policyID),
 		}
 
-		log.Printf("[DEBUG] Listing Organizations Policies for Target: %s", input)
-		var output *organizations.PolicySummary
-		err = conn.ListPoliciesForTargetPages(input, func(page *organizations.ListPoliciesForTargetOutput, lastPage bool) bool {
-			for _, policySummary := range page.Policies {
-				if aws.StringValue(policySummary.Id) == policyID {
-					output = policySummary
-					return true
+		log.Printf("[DEBUG] Listing Organizations Policy Targets: %s", input)
+		var output *organizations.PolicyTargetSummary
+		err = conn.ListTargetsForPolicyPages(input, func(page *organizations.ListTargetsForPolicyOutput, lastPage bool) bool {
+			for _, targetSummary := range page.Targets {
+				if aws.StringValue(targetSummary.TargetId) == targetID {
+					output = targetSummary
+					return false
 				}
 			}
 			return !lastPage
@@ -84,6 +84,42 @@ func TestAccAwsOrganizationsPolicy_description(t *testing.T) {
 	})
 }
 
+func testAccAwsOrganizationsPolicy_Type(t *testing.T) {
+	var policy organizations.Policy
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_organizations_policy.test"
+	aiServicesOptOutPolicyContent := `{"services": {"default": {"opt_out_policy": {"@@assign": "optOut"}}}}`
+	tagPolicyContent := `{"tags": {"Product": {"tag_key": {"@@assign": "Product"}}}}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccOrganizationsAccountPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsOrganizationsPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsOrganizationsPolicyConfig_Type(rName, tagPolicyContent, organizationsPolicyTypeTagPolicy),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsOrganizationsPolicyExists(resourceName, &policy),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:organizations::[^:]+:policy/o-.+/tag_policy/p-.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "type", organizationsPolicyTypeTagPolicy),
+				),
+			},
+			{
+				Config: testAccAwsOrganizationsPolicyConfig_Type(rName, aiServicesOptOutPolicyContent, organizationsPolicyTypeAiservicesOptOutPolicy),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsOrganizationsPolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "type", organizationsPolicyTypeAiservicesOptOutPolicy),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckAwsOrganizationsPolicyDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).organizationsconn
 
@@ -161,3 +197,19 @@ resource "aws_organizations_policy" "test" {
 }
 `, strconv.Quote(content), rName)
 }
+
+func testAccAwsOrganizationsPolicyConfig_Type(rName, content, policyType string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_organization" "test" {
+  enabled_policy_types = [%[3]q]
+}
+
+resource "aws_organizations_policy" "test" {
+  depends_on = ["aws_organizations_organization.test"]
+
+  content = %[1]s
+  name    = %[2]q
+  type    = %[3]q
+}
+`, strconv.Quote(content), rName, policyType)
+}
@@ -0,0 +1,194 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsQuickSightGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsQuickSightGroupCreate,
+		Read:   resourceAwsQuickSightGroupRead,
+		Update: resourceAwsQuickSightGroupUpdate,
+		Delete: resourceAwsQuickSightGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"aws_account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+
+			"group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"namespace": quickSightNamespaceSchema(),
+		},
+	}
+}
+
+func resourceAwsQuickSightGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID := meta.(*AWSClient).accountid
+	namespace := d.Get("namespace").(string)
+	groupName := d.Get("group_name").(string)
+
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+
+	input := &quicksight.CreateGroupInput{
+		AwsAccountId: aws.String(awsAccountID),
+		Namespace:    aws.String(namespace),
+		GroupName:    aws.String(groupName),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating QuickSight Group: %s", input)
+	if _, err := conn.CreateGroup(input); err != nil {
+		return fmt.Errorf("error creating QuickSight Group (%s): %s", groupName, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", awsAccountID, namespace, groupName))
+
+	return resourceAwsQuickSightGroupRead(d, meta)
+}
+
+func resourceAwsQuickSightGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, namespace, groupName, err := decodeQuickSightGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	output, err := conn.DescribeGroup(&quicksight.DescribeGroupInput{
+		AwsAccountId: aws.String(awsAccountID),
+		Namespace:    aws.String(namespace),
+		GroupName:    aws.String(groupName),
+	})
+
+	if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] QuickSight Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading QuickSight Group (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.Group == nil {
+		return fmt.Errorf("error reading QuickSight Group (%s): empty response", d.Id())
+	}
+
+	d.Set("arn", output.Group.Arn)
+	d.Set("aws_account_id", awsAccountID)
+	d.Set("description", output.Group.Description)
+	d.Set("group_name", output.Group.GroupName)
+	d.Set("namespace", namespace)
+
+	return nil
+}
+
+func resourceAwsQuickSightGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, namespace, groupName, err := decodeQuickSightGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &quicksight.UpdateGroupInput{
+		AwsAccountId: aws.String(awsAccountID),
+		Namespace:    aws.String(namespace),
+		GroupName:    aws.String(groupName),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating QuickSight Group: %s", input)
+	if _, err := conn.UpdateGroup(input); err != nil {
+		return fmt.Errorf("error updating QuickSight Group (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsQuickSightGroupRead(d, meta)
+}
+
+func resourceAwsQuickSightGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, namespace, groupName, err := decodeQuickSightGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting QuickSight Group: %s", d.Id())
+	_, err = conn.DeleteGroup(&quicksight.DeleteGroupInput{
+		AwsAccountId: aws.String(awsAccountID),
+		Namespace:    aws.String(namespace),
+		GroupName:    aws.String(groupName),
+	})
+
+	if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting QuickSight Group (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// quickSightNamespaceSchema returns the schema of the namespace argument.
+// QuickSight currently only supports the default namespace.
+func quickSightNamespaceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+		Default:  "default",
+		ValidateFunc: validation.StringInSlice([]string{
+			"default",
+		}, false),
+	}
+}
+
+func decodeQuickSightGroupID(id string) (string, string, string, error) {
+	parts := strings.SplitN(id, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("expected ID in format AWS_ACCOUNT_ID/NAMESPACE/GROUP_NAME, provided: %s", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
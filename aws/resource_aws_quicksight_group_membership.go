@@ -0,0 +1,180 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsQuickSightGroupMembership() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsQuickSightGroupMembershipCreate,
+		Read:   resourceAwsQuickSightGroupMembershipRead,
+		Delete: resourceAwsQuickSightGroupMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"aws_account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"member_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"namespace": quickSightNamespaceSchema(),
+		},
+	}
+}
+
+func resourceAwsQuickSightGroupMembershipCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID := meta.(*AWSClient).accountid
+	namespace := d.Get("namespace").(string)
+	groupName := d.Get("group_name").(string)
+	memberName := d.Get("member_name").(string)
+
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+
+	input := &quicksight.CreateGroupMembershipInput{
+		AwsAccountId: aws.String(awsAccountID),
+		Namespace:    aws.String(namespace),
+		GroupName:    aws.String(groupName),
+		MemberName:   aws.String(memberName),
+	}
+
+	log.Printf("[DEBUG] Creating QuickSight Group Membership: %s", input)
+	if _, err := conn.CreateGroupMembership(input); err != nil {
+		return fmt.Errorf("error adding QuickSight User (%s) to Group (%s): %s", memberName, groupName, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", awsAccountID, namespace, groupName, memberName))
+
+	return resourceAwsQuickSightGroupMembershipRead(d, meta)
+}
+
+func resourceAwsQuickSightGroupMembershipRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, namespace, groupName, memberName, err := decodeQuickSightGroupMembershipID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	member, err := findQuickSightGroupMember(conn, awsAccountID, namespace, groupName, memberName)
+
+	if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] QuickSight Group (%s/%s/%s) not found, removing membership from state", awsAccountID, namespace, groupName)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading QuickSight Group Membership (%s): %s", d.Id(), err)
+	}
+
+	if member == nil {
+		log.Printf("[WARN] QuickSight Group Membership (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", member.Arn)
+	d.Set("aws_account_id", awsAccountID)
+	d.Set("group_name", groupName)
+	d.Set("member_name", member.MemberName)
+	d.Set("namespace", namespace)
+
+	return nil
+}
+
+func resourceAwsQuickSightGroupMembershipDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, namespace, groupName, memberName, err := decodeQuickSightGroupMembershipID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting QuickSight Group Membership: %s", d.Id())
+	_, err = conn.DeleteGroupMembership(&quicksight.DeleteGroupMembershipInput{
+		AwsAccountId: aws.String(awsAccountID),
+		Namespace:    aws.String(namespace),
+		GroupName:    aws.String(groupName),
+		MemberName:   aws.String(memberName),
+	})
+
+	if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error removing QuickSight User (%s) from Group (%s): %s", memberName, groupName, err)
+	}
+
+	return nil
+}
+
+// findQuickSightGroupMember returns the named member of the group, or nil if
+// the user is not a member. The vendored SDK has no paginator for
+// ListGroupMemberships, so the pages are walked by hand.
+func findQuickSightGroupMember(conn *quicksight.QuickSight, awsAccountID, namespace, groupName, memberName string) (*quicksight.GroupMember, error) {
+	input := &quicksight.ListGroupMembershipsInput{
+		AwsAccountId: aws.String(awsAccountID),
+		Namespace:    aws.String(namespace),
+		GroupName:    aws.String(groupName),
+	}
+
+	for {
+		output, err := conn.ListGroupMemberships(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, member := range output.GroupMemberList {
+			if aws.StringValue(member.MemberName) == memberName {
+				return member, nil
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			return nil, nil
+		}
+
+		input.NextToken = output.NextToken
+	}
+}
+
+func decodeQuickSightGroupMembershipID(id string) (string, string, string, string, error) {
+	parts := strings.SplitN(id, "/", 4)
+	if len(parts) != 4 || parts[0] == "" || parts[1] == "" || parts[2] == "" || parts[3] == "" {
+		return "", "", "", "", fmt.Errorf("expected ID in format AWS_ACCOUNT_ID/NAMESPACE/GROUP_NAME/MEMBER_NAME, provided: %s", id)
+	}
+	return parts[0], parts[1], parts[2], parts[3], nil
+}
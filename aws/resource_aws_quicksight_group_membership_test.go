@@ -0,0 +1,117 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSQuickSightGroupMembership_basic(t *testing.T) {
+	resourceName := "aws_quicksight_group_membership.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckQuickSightGroupMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightGroupMembershipConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightGroupMembershipExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "group_name", "aws_quicksight_group.test", "group_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "member_name", "aws_quicksight_user.test", "user_name"),
+					resource.TestCheckResourceAttr(resourceName, "namespace", "default"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckQuickSightGroupMembershipExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		awsAccountID, namespace, groupName, memberName, err := decodeQuickSightGroupMembershipID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+
+		member, err := findQuickSightGroupMember(conn, awsAccountID, namespace, groupName, memberName)
+
+		if err != nil {
+			return err
+		}
+
+		if member == nil {
+			return fmt.Errorf("QuickSight Group Membership (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckQuickSightGroupMembershipDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_quicksight_group_membership" {
+			continue
+		}
+
+		awsAccountID, namespace, groupName, memberName, err := decodeQuickSightGroupMembershipID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		member, err := findQuickSightGroupMember(conn, awsAccountID, namespace, groupName, memberName)
+
+		if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if member != nil {
+			return fmt.Errorf("QuickSight Group Membership (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSQuickSightGroupMembershipConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_group" "test" {
+  group_name = %[1]q
+}
+
+resource "aws_quicksight_user" "test" {
+  user_name     = %[1]q
+  email         = "test@example.com"
+  identity_type = "QUICKSIGHT"
+  user_role     = "READER"
+}
+
+resource "aws_quicksight_group_membership" "test" {
+  group_name  = "${aws_quicksight_group.test.group_name}"
+  member_name = "${aws_quicksight_user.test.user_name}"
+}
+`, rName)
+}
@@ -0,0 +1,241 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsQuickSightUser() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsQuickSightUserCreate,
+		Read:   resourceAwsQuickSightUserRead,
+		Update: resourceAwsQuickSightUserUpdate,
+		Delete: resourceAwsQuickSightUserDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"aws_account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"email": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"iam_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"identity_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					quicksight.IdentityTypeIam,
+					quicksight.IdentityTypeQuicksight,
+				}, false),
+			},
+
+			"invitation_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"namespace": quickSightNamespaceSchema(),
+
+			"session_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"user_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"user_role": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					quicksight.UserRoleAdmin,
+					quicksight.UserRoleAuthor,
+					quicksight.UserRoleReader,
+					quicksight.UserRoleRestrictedAuthor,
+					quicksight.UserRoleRestrictedReader,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsQuickSightUserCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID := meta.(*AWSClient).accountid
+	namespace := d.Get("namespace").(string)
+
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+
+	input := &quicksight.RegisterUserInput{
+		AwsAccountId: aws.String(awsAccountID),
+		Email:        aws.String(d.Get("email").(string)),
+		IdentityType: aws.String(d.Get("identity_type").(string)),
+		Namespace:    aws.String(namespace),
+		UserRole:     aws.String(d.Get("user_role").(string)),
+	}
+
+	if v, ok := d.GetOk("iam_arn"); ok {
+		input.IamArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("session_name"); ok {
+		input.SessionName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("user_name"); ok {
+		input.UserName = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Registering QuickSight User: %s", input)
+	output, err := conn.RegisterUser(input)
+
+	if err != nil {
+		return fmt.Errorf("error registering QuickSight User: %s", err)
+	}
+
+	if output == nil || output.User == nil {
+		return fmt.Errorf("error registering QuickSight User: empty response")
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", awsAccountID, namespace, aws.StringValue(output.User.UserName)))
+
+	// The invitation URL is only returned on registration.
+	d.Set("invitation_url", output.UserInvitationUrl)
+
+	return resourceAwsQuickSightUserRead(d, meta)
+}
+
+func resourceAwsQuickSightUserRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, namespace, userName, err := decodeQuickSightUserID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	output, err := conn.DescribeUser(&quicksight.DescribeUserInput{
+		AwsAccountId: aws.String(awsAccountID),
+		Namespace:    aws.String(namespace),
+		UserName:     aws.String(userName),
+	})
+
+	if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] QuickSight User (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading QuickSight User (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.User == nil {
+		return fmt.Errorf("error reading QuickSight User (%s): empty response", d.Id())
+	}
+
+	d.Set("arn", output.User.Arn)
+	d.Set("aws_account_id", awsAccountID)
+	d.Set("email", output.User.Email)
+	d.Set("identity_type", output.User.IdentityType)
+	d.Set("namespace", namespace)
+	d.Set("user_name", output.User.UserName)
+	d.Set("user_role", output.User.Role)
+
+	return nil
+}
+
+func resourceAwsQuickSightUserUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, namespace, userName, err := decodeQuickSightUserID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &quicksight.UpdateUserInput{
+		AwsAccountId: aws.String(awsAccountID),
+		Email:        aws.String(d.Get("email").(string)),
+		Namespace:    aws.String(namespace),
+		Role:         aws.String(d.Get("user_role").(string)),
+		UserName:     aws.String(userName),
+	}
+
+	log.Printf("[DEBUG] Updating QuickSight User: %s", input)
+	if _, err := conn.UpdateUser(input); err != nil {
+		return fmt.Errorf("error updating QuickSight User (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsQuickSightUserRead(d, meta)
+}
+
+func resourceAwsQuickSightUserDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, namespace, userName, err := decodeQuickSightUserID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting QuickSight User: %s", d.Id())
+	_, err = conn.DeleteUser(&quicksight.DeleteUserInput{
+		AwsAccountId: aws.String(awsAccountID),
+		Namespace:    aws.String(namespace),
+		UserName:     aws.String(userName),
+	})
+
+	if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting QuickSight User (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// IAM identity user names are of the form ROLE/SESSION, so everything after
+// the namespace is the user name.
+func decodeQuickSightUserID(id string) (string, string, string, error) {
+	parts := strings.SplitN(id, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("expected ID in format AWS_ACCOUNT_ID/NAMESPACE/USER_NAME, provided: %s", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
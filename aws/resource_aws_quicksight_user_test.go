@@ -0,0 +1,130 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSQuickSightUser_basic(t *testing.T) {
+	var user quicksight.User
+	resourceName := "aws_quicksight_user.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckQuickSightUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightUserConfig(rName, "test1@example.com", quicksight.UserRoleReader),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightUserExists(resourceName, &user),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "quicksight", fmt.Sprintf("user/default/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "email", "test1@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "identity_type", quicksight.IdentityTypeQuicksight),
+					resource.TestCheckResourceAttr(resourceName, "namespace", "default"),
+					resource.TestCheckResourceAttr(resourceName, "user_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "user_role", quicksight.UserRoleReader),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"invitation_url"},
+			},
+			{
+				Config: testAccAWSQuickSightUserConfig(rName, "test2@example.com", quicksight.UserRoleAuthor),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightUserExists(resourceName, &user),
+					resource.TestCheckResourceAttr(resourceName, "email", "test2@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "user_role", quicksight.UserRoleAuthor),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckQuickSightUserExists(resourceName string, user *quicksight.User) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		awsAccountID, namespace, userName, err := decodeQuickSightUserID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+
+		output, err := conn.DescribeUser(&quicksight.DescribeUserInput{
+			AwsAccountId: aws.String(awsAccountID),
+			Namespace:    aws.String(namespace),
+			UserName:     aws.String(userName),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.User == nil {
+			return fmt.Errorf("QuickSight User (%s) not found", rs.Primary.ID)
+		}
+
+		*user = *output.User
+
+		return nil
+	}
+}
+
+func testAccCheckQuickSightUserDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_quicksight_user" {
+			continue
+		}
+
+		awsAccountID, namespace, userName, err := decodeQuickSightUserID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.DescribeUser(&quicksight.DescribeUserInput{
+			AwsAccountId: aws.String(awsAccountID),
+			Namespace:    aws.String(namespace),
+			UserName:     aws.String(userName),
+		})
+
+		if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("QuickSight User (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSQuickSightUserConfig(rName, email, role string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_user" "test" {
+  user_name     = %[1]q
+  email         = %[2]q
+  identity_type = "QUICKSIGHT"
+  user_role     = %[3]q
+}
+`, rName, email, role)
+}
@@ -0,0 +1,337 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsRamResourceShareAccepter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRamResourceShareAccepterCreate,
+		Read:   resourceAwsRamResourceShareAccepterRead,
+		Delete: resourceAwsRamResourceShareAccepterDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"share_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"invitation_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"share_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"receiver_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"sender_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"share_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceAwsRamResourceShareAccepterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ramconn
+
+	shareARN := d.Get("share_arn").(string)
+
+	invitation, err := resourceAwsRamResourceShareGetInvitation(conn, shareARN, ram.ResourceShareInvitationStatusPending)
+
+	if err != nil {
+		return fmt.Errorf("error reading RAM Resource Share (%s) invitations: %s", shareARN, err)
+	}
+
+	if invitation == nil {
+		return fmt.Errorf("no pending invitation found for RAM Resource Share (%s)", shareARN)
+	}
+
+	input := &ram.AcceptResourceShareInvitationInput{
+		ClientToken:                aws.String(resource.UniqueId()),
+		ResourceShareInvitationArn: invitation.ResourceShareInvitationArn,
+	}
+
+	log.Printf("[DEBUG] Accepting RAM Resource Share invitation: %s", input)
+	_, err = conn.AcceptResourceShareInvitation(input)
+
+	if err != nil {
+		return fmt.Errorf("error accepting RAM Resource Share (%s) invitation: %s", shareARN, err)
+	}
+
+	d.SetId(shareARN)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ram.ResourceShareInvitationStatusPending, ram.ResourceShareAssociationStatusAssociating},
+		Target:  []string{ram.ResourceShareInvitationStatusAccepted},
+		Refresh: resourceAwsRamResourceShareAccepterStateRefreshFunc(conn, aws.StringValue(invitation.ResourceShareInvitationArn)),
+		Timeout: d.Timeout(schema.TimeoutCreate),
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for RAM Resource Share (%s) invitation acceptance: %s", d.Id(), err)
+	}
+
+	return resourceAwsRamResourceShareAccepterRead(d, meta)
+}
+
+func resourceAwsRamResourceShareAccepterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ramconn
+
+	resourceShare, err := resourceAwsRamResourceShareGetSharedWithMe(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading RAM Resource Share (%s): %s", d.Id(), err)
+	}
+
+	if resourceShare == nil || aws.StringValue(resourceShare.Status) == ram.ResourceShareStatusDeleted {
+		log.Printf("[WARN] RAM Resource Share (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	shareID, err := resourceAwsRamResourceShareAccepterShareID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	d.Set("receiver_account_id", meta.(*AWSClient).accountid)
+	d.Set("sender_account_id", resourceShare.OwningAccountId)
+	d.Set("share_arn", resourceShare.ResourceShareArn)
+	d.Set("share_id", shareID)
+	d.Set("share_name", resourceShare.Name)
+	d.Set("status", resourceShare.Status)
+
+	// Invitations expire some time after they are accepted
+	invitation, err := resourceAwsRamResourceShareGetInvitation(conn, d.Id(), "")
+
+	if err != nil {
+		return fmt.Errorf("error reading RAM Resource Share (%s) invitations: %s", d.Id(), err)
+	}
+
+	if invitation != nil {
+		d.Set("invitation_arn", invitation.ResourceShareInvitationArn)
+	}
+
+	var resources []string
+
+	input := &ram.ListResourcesInput{
+		ResourceOwner:     aws.String(ram.ResourceOwnerOtherAccounts),
+		ResourceShareArns: aws.StringSlice([]string{d.Id()}),
+	}
+
+	err = conn.ListResourcesPages(input, func(page *ram.ListResourcesOutput, lastPage bool) bool {
+		for _, r := range page.Resources {
+			resources = append(resources, aws.StringValue(r.Arn))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing RAM Resource Share (%s) resources: %s", d.Id(), err)
+	}
+
+	if err := d.Set("resources", resources); err != nil {
+		return fmt.Errorf("error setting resources: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsRamResourceShareAccepterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ramconn
+
+	receiverAccountID := d.Get("receiver_account_id").(string)
+
+	if receiverAccountID == "" {
+		receiverAccountID = meta.(*AWSClient).accountid
+	}
+
+	input := &ram.DisassociateResourceShareInput{
+		ClientToken:      aws.String(resource.UniqueId()),
+		Principals:       aws.StringSlice([]string{receiverAccountID}),
+		ResourceShareArn: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Leaving RAM Resource Share: %s", input)
+	_, err := conn.DisassociateResourceShare(input)
+
+	if isAWSErr(err, ram.ErrCodeUnknownResourceException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error leaving RAM Resource Share (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ram.ResourceShareStatusActive, ram.ResourceShareStatusDeleting},
+		Target:  []string{ram.ResourceShareStatusDeleted},
+		Refresh: func() (interface{}, string, error) {
+			resourceShare, err := resourceAwsRamResourceShareGetSharedWithMe(conn, d.Id())
+
+			if err != nil {
+				return nil, "", err
+			}
+
+			if resourceShare == nil {
+				return "", ram.ResourceShareStatusDeleted, nil
+			}
+
+			return resourceShare, aws.StringValue(resourceShare.Status), nil
+		},
+		Timeout: d.Timeout(schema.TimeoutDelete),
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for RAM Resource Share (%s) to be left: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsRamResourceShareAccepterShareID(shareARN string) (string, error) {
+	parsedARN, err := arn.Parse(shareARN)
+
+	if err != nil {
+		return "", fmt.Errorf("error parsing RAM Resource Share ARN (%s): %s", shareARN, err)
+	}
+
+	return strings.TrimPrefix(parsedARN.Resource, "resource-share/"), nil
+}
+
+// resourceAwsRamResourceShareAccepterStateRefreshFunc reports the invitation status, or
+// ASSOCIATING while the receiving account's principal association is still in progress.
+func resourceAwsRamResourceShareAccepterStateRefreshFunc(conn *ram.RAM, invitationARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.GetResourceShareInvitations(&ram.GetResourceShareInvitationsInput{
+			ResourceShareInvitationArns: aws.StringSlice([]string{invitationARN}),
+		})
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil || len(output.ResourceShareInvitations) == 0 || output.ResourceShareInvitations[0] == nil {
+			return nil, "", nil
+		}
+
+		invitation := output.ResourceShareInvitations[0]
+
+		if aws.StringValue(invitation.Status) != ram.ResourceShareInvitationStatusAccepted {
+			return invitation, aws.StringValue(invitation.Status), nil
+		}
+
+		for _, association := range invitation.ResourceShareAssociations {
+			switch aws.StringValue(association.Status) {
+			case ram.ResourceShareAssociationStatusAssociating:
+				return invitation, ram.ResourceShareAssociationStatusAssociating, nil
+			case ram.ResourceShareAssociationStatusFailed:
+				return invitation, ram.ResourceShareAssociationStatusFailed, fmt.Errorf("association status message: %s", aws.StringValue(association.StatusMessage))
+			}
+		}
+
+		return invitation, ram.ResourceShareInvitationStatusAccepted, nil
+	}
+}
+
+// resourceAwsRamResourceShareGetInvitation returns the most recent invitation to the
+// given resource share, optionally limited to invitations with the given status.
+func resourceAwsRamResourceShareGetInvitation(conn *ram.RAM, shareARN, status string) (*ram.ResourceShareInvitation, error) {
+	var invitation *ram.ResourceShareInvitation
+
+	input := &ram.GetResourceShareInvitationsInput{
+		ResourceShareArns: aws.StringSlice([]string{shareARN}),
+	}
+
+	err := conn.GetResourceShareInvitationsPages(input, func(page *ram.GetResourceShareInvitationsOutput, lastPage bool) bool {
+		for _, rsi := range page.ResourceShareInvitations {
+			if rsi == nil {
+				continue
+			}
+
+			if status != "" && aws.StringValue(rsi.Status) != status {
+				continue
+			}
+
+			if invitation == nil || aws.TimeValue(rsi.InvitationTimestamp).After(aws.TimeValue(invitation.InvitationTimestamp)) {
+				invitation = rsi
+			}
+		}
+
+		return !lastPage
+	})
+
+	if isAWSErr(err, ram.ErrCodeResourceShareInvitationArnNotFoundException, "") {
+		return nil, nil
+	}
+
+	return invitation, err
+}
+
+// resourceAwsRamResourceShareGetSharedWithMe returns the resource share as seen by a receiving account.
+func resourceAwsRamResourceShareGetSharedWithMe(conn *ram.RAM, shareARN string) (*ram.ResourceShare, error) {
+	output, err := conn.GetResourceShares(&ram.GetResourceSharesInput{
+		ResourceOwner:     aws.String(ram.ResourceOwnerOtherAccounts),
+		ResourceShareArns: aws.StringSlice([]string{shareARN}),
+	})
+
+	if isAWSErr(err, ram.ErrCodeUnknownResourceException, "") {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ResourceShares) == 0 || output.ResourceShares[0] == nil {
+		return nil, nil
+	}
+
+	return output.ResourceShares[0], nil
+}
@@ -0,0 +1,147 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAwsRamResourceShareAccepter_basic(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_ram_resource_share_accepter.test"
+	shareResourceName := "aws_ram_resource_share.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckAwsRamResourceShareAccepterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsRamResourceShareAccepterConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRamResourceShareAccepterExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "share_arn", shareResourceName, "arn"),
+					resource.TestMatchResourceAttr(resourceName, "invitation_arn", regexp.MustCompile(`^arn:[^:]+:ram:[^:]+:[0-9]{12}:resource-share-invitation/.+$`)),
+					resource.TestMatchResourceAttr(resourceName, "share_id", regexp.MustCompile(`^rs-.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "status", ram.ResourceShareStatusActive),
+					resource.TestCheckResourceAttrPair(resourceName, "receiver_account_id", "data.aws_caller_identity.receiver", "account_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "sender_account_id", "data.aws_caller_identity.sender", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "share_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "resources.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "resources.0", "aws_ec2_transit_gateway.test", "arn"),
+				),
+			},
+			{
+				Config:            testAccAwsRamResourceShareAccepterConfig(rName),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsRamResourceShareAccepterDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ramconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ram_resource_share_accepter" {
+			continue
+		}
+
+		resourceShare, err := resourceAwsRamResourceShareGetSharedWithMe(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if resourceShare != nil && aws.StringValue(resourceShare.Status) != ram.ResourceShareStatusDeleted {
+			return fmt.Errorf("RAM Resource Share (%s) still shared with this account", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsRamResourceShareAccepterExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No RAM Resource Share ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ramconn
+
+		resourceShare, err := resourceAwsRamResourceShareGetSharedWithMe(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if resourceShare == nil || aws.StringValue(resourceShare.Status) != ram.ResourceShareStatusActive {
+			return fmt.Errorf("RAM Resource Share (%s) not shared with this account", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAwsRamResourceShareAccepterConfig(rName string) string {
+	return testAccAlternateAccountProviderConfig() + fmt.Sprintf(`
+data "aws_caller_identity" "receiver" {}
+
+data "aws_caller_identity" "sender" {
+  provider = "aws.alternate"
+}
+
+resource "aws_ec2_transit_gateway" "test" {
+  provider = "aws.alternate"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ram_resource_share" "test" {
+  provider = "aws.alternate"
+
+  name                      = %[1]q
+  allow_external_principals = true
+}
+
+resource "aws_ram_resource_association" "test" {
+  provider = "aws.alternate"
+
+  resource_arn       = "${aws_ec2_transit_gateway.test.arn}"
+  resource_share_arn = "${aws_ram_resource_share.test.arn}"
+}
+
+resource "aws_ram_principal_association" "test" {
+  provider = "aws.alternate"
+
+  principal          = "${data.aws_caller_identity.receiver.account_id}"
+  resource_share_arn = "${aws_ram_resource_share.test.arn}"
+}
+
+resource "aws_ram_resource_share_accepter" "test" {
+  share_arn = "${aws_ram_principal_association.test.resource_share_arn}"
+
+  depends_on = ["aws_ram_resource_association.test"]
+}
+`, rName)
+}
@@ -0,0 +1,279 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	rdsReservedInstanceStateActive         = "active"
+	rdsReservedInstanceStatePaymentPending = "payment-pending"
+)
+
+func resourceAwsRdsReservedInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRdsReservedInstanceCreate,
+		Read:   resourceAwsRdsReservedInstanceRead,
+		Update: resourceAwsRdsReservedInstanceUpdate,
+		Delete: resourceAwsRdsReservedInstanceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"confirm_purchase": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"currency_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"db_instance_class": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"duration": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"fixed_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"instance_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"multi_az": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"offering_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"offering_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"product_description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"recurring_charges": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"recurring_charge_amount": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"recurring_charge_frequency": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"reservation_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+			"usage_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsRdsReservedInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).rdsconn
+
+	// The purchase is billed immediately and cannot be undone
+	if !d.Get("confirm_purchase").(bool) {
+		return fmt.Errorf("refusing to purchase RDS Reserved Instance offering (%s): confirm_purchase must be set to true", d.Get("offering_id").(string))
+	}
+
+	input := &rds.PurchaseReservedDBInstancesOfferingInput{
+		DBInstanceCount:               aws.Int64(int64(d.Get("instance_count").(int))),
+		ReservedDBInstancesOfferingId: aws.String(d.Get("offering_id").(string)),
+		Tags:                          tagsFromMapRDS(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("reservation_id"); ok {
+		input.ReservedDBInstanceId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Purchasing RDS Reserved Instance: %s", input)
+	output, err := conn.PurchaseReservedDBInstancesOffering(input)
+
+	if err != nil {
+		return fmt.Errorf("error purchasing RDS Reserved Instance: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.ReservedDBInstance.ReservedDBInstanceId))
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{rdsReservedInstanceStatePaymentPending},
+		Target:  []string{rdsReservedInstanceStateActive},
+		Refresh: func() (interface{}, string, error) {
+			reservation, err := describeRdsReservedInstance(conn, d.Id())
+
+			if err != nil {
+				return nil, "", err
+			}
+
+			if reservation == nil {
+				return nil, "", nil
+			}
+
+			return reservation, aws.StringValue(reservation.State), nil
+		},
+		Timeout: d.Timeout(schema.TimeoutCreate),
+	}
+
+	log.Printf("[DEBUG] Waiting for RDS Reserved Instance (%s) to become active", d.Id())
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for RDS Reserved Instance (%s) to become active: %s", d.Id(), err)
+	}
+
+	return resourceAwsRdsReservedInstanceRead(d, meta)
+}
+
+func resourceAwsRdsReservedInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).rdsconn
+
+	reservation, err := describeRdsReservedInstance(conn, d.Id())
+
+	if isAWSErr(err, rds.ErrCodeReservedDBInstanceNotFoundFault, "") {
+		log.Printf("[WARN] RDS Reserved Instance (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading RDS Reserved Instance (%s): %s", d.Id(), err)
+	}
+
+	if reservation == nil {
+		log.Printf("[WARN] RDS Reserved Instance (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	arn := aws.StringValue(reservation.ReservedDBInstanceArn)
+	d.Set("arn", arn)
+	d.Set("currency_code", reservation.CurrencyCode)
+	d.Set("db_instance_class", reservation.DBInstanceClass)
+	d.Set("duration", reservation.Duration)
+	d.Set("fixed_price", reservation.FixedPrice)
+	d.Set("instance_count", reservation.DBInstanceCount)
+	d.Set("multi_az", reservation.MultiAZ)
+	d.Set("offering_id", reservation.ReservedDBInstancesOfferingId)
+	d.Set("offering_type", reservation.OfferingType)
+	d.Set("product_description", reservation.ProductDescription)
+	d.Set("reservation_id", reservation.ReservedDBInstanceId)
+	d.Set("state", reservation.State)
+	d.Set("usage_price", reservation.UsagePrice)
+
+	if reservation.StartTime != nil {
+		d.Set("start_time", aws.TimeValue(reservation.StartTime).Format(time.RFC3339))
+	}
+
+	if err := d.Set("recurring_charges", flattenRdsRecurringCharges(reservation.RecurringCharges)); err != nil {
+		return fmt.Errorf("error setting recurring_charges: %s", err)
+	}
+
+	if err := saveTagsRDS(conn, d, arn); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsRdsReservedInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).rdsconn
+
+	if err := setTagsRDS(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating RDS Reserved Instance (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsRdsReservedInstanceRead(d, meta)
+}
+
+func resourceAwsRdsReservedInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARN] RDS Reserved Instance (%s) cannot be cancelled, removing from state only", d.Id())
+
+	return nil
+}
+
+func describeRdsReservedInstance(conn *rds.RDS, reservationId string) (*rds.ReservedDBInstance, error) {
+	output, err := conn.DescribeReservedDBInstances(&rds.DescribeReservedDBInstancesInput{
+		ReservedDBInstanceId: aws.String(reservationId),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, reservation := range output.ReservedDBInstances {
+		if aws.StringValue(reservation.ReservedDBInstanceId) == reservationId {
+			return reservation, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func flattenRdsRecurringCharges(recurringCharges []*rds.RecurringCharge) []interface{} {
+	l := make([]interface{}, 0, len(recurringCharges))
+
+	for _, recurringCharge := range recurringCharges {
+		if recurringCharge == nil {
+			continue
+		}
+
+		m := map[string]interface{}{
+			"recurring_charge_amount":    aws.Float64Value(recurringCharge.RecurringChargeAmount),
+			"recurring_charge_frequency": aws.StringValue(recurringCharge.RecurringChargeFrequency),
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
@@ -0,0 +1,139 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSRdsReservedInstance_basic(t *testing.T) {
+	// Purchasing a reservation cannot be undone and is billed immediately.
+	if os.Getenv("RUN_RDS_RESERVED_INSTANCE_TESTS") != "true" {
+		t.Skip("Environment variable RUN_RDS_RESERVED_INSTANCE_TESTS is not set to true")
+	}
+
+	var reservation rds.ReservedDBInstance
+	resourceName := "aws_rds_reserved_instance.test"
+	dataSourceName := "data.aws_rds_reserved_instance_offering.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRdsReservedInstanceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRdsReservedInstanceExists(resourceName, &reservation),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`:ri:`)),
+					resource.TestCheckResourceAttrPair(resourceName, "currency_code", dataSourceName, "currency_code"),
+					resource.TestCheckResourceAttrPair(resourceName, "db_instance_class", dataSourceName, "db_instance_class"),
+					resource.TestCheckResourceAttrPair(resourceName, "duration", dataSourceName, "duration"),
+					resource.TestCheckResourceAttr(resourceName, "instance_count", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "offering_id", dataSourceName, "offering_id"),
+					resource.TestCheckResourceAttr(resourceName, "reservation_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "state", rdsReservedInstanceStateActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"confirm_purchase"},
+			},
+		},
+	})
+}
+
+func TestAccAWSRdsReservedInstance_ConfirmPurchaseFalse(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSRdsReservedInstanceConfig_confirmPurchaseFalse(),
+				ExpectError: regexp.MustCompile(`confirm_purchase must be set to true`),
+			},
+		},
+	})
+}
+
+func TestResourceAwsRdsReservedInstanceCreate_ConfirmPurchaseFalse(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceAwsRdsReservedInstance().Schema, map[string]interface{}{
+		"confirm_purchase": false,
+		"offering_id":      "test-offering",
+	})
+
+	// A nil service client fails the test if a purchase is attempted
+	err := resourceAwsRdsReservedInstanceCreate(d, &AWSClient{})
+
+	if err == nil || !strings.Contains(err.Error(), "confirm_purchase must be set to true") {
+		t.Fatalf("expected confirm_purchase error, got: %v", err)
+	}
+
+	if d.Id() != "" {
+		t.Errorf("expected no ID to be set, got %q", d.Id())
+	}
+}
+
+func testAccCheckAWSRdsReservedInstanceExists(resourceName string, reservation *rds.ReservedDBInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No RDS Reserved Instance ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).rdsconn
+
+		output, err := describeRdsReservedInstance(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("RDS Reserved Instance (%s) not found", rs.Primary.ID)
+		}
+
+		*reservation = *output
+
+		return nil
+	}
+}
+
+func testAccAWSRdsReservedInstanceConfig_basic(rName string) string {
+	return testAccAWSRdsReservedInstanceOfferingDataSourceConfig_basic + fmt.Sprintf(`
+resource "aws_rds_reserved_instance" "test" {
+  confirm_purchase = true
+  offering_id      = "${data.aws_rds_reserved_instance_offering.test.offering_id}"
+  reservation_id   = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccAWSRdsReservedInstanceConfig_confirmPurchaseFalse() string {
+	return testAccAWSRdsReservedInstanceOfferingDataSourceConfig_basic + `
+resource "aws_rds_reserved_instance" "test" {
+  confirm_purchase = false
+  offering_id      = "${data.aws_rds_reserved_instance_offering.test.offering_id}"
+}
+`
+}
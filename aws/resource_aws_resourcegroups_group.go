@@ -41,8 +41,10 @@ func resourceAwsResourceGroupsGroup() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"query": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validation.ValidateJsonString,
+							DiffSuppressFunc: suppressEquivalentJsonDiffs,
 						},
 
 						"type": {
@@ -50,6 +52,7 @@ func resourceAwsResourceGroupsGroup() *schema.Resource {
 							Optional: true,
 							Default:  resourcegroups.QueryTypeTagFilters10,
 							ValidateFunc: validation.StringInSlice([]string{
+								resourcegroups.QueryTypeCloudformationStack10,
 								resourcegroups.QueryTypeTagFilters10,
 							}, false),
 						},
@@ -75,6 +75,31 @@ func TestAccAWSResourceGroup_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSResourceGroup_CloudFormationStack(t *testing.T) {
+	resourceName := "aws_resourcegroups_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSResourceGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSResourceGroupConfig_CloudFormationStack(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSResourceGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_query.0.type", "CLOUDFORMATION_STACK_1_0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckAWSResourceGroupExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -98,7 +123,7 @@ func testAccCheckAWSResourceGroupExists(n string) resource.TestCheckFunc {
 
 func testAccCheckAWSResourceGroupDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
-		if rs.Type != "aws_waf_rule_group" {
+		if rs.Type != "aws_resourcegroups_group" {
 			continue
 		}
 
@@ -138,3 +163,38 @@ JSON
 }
 `, rName, desc, query)
 }
+
+func testAccAWSResourceGroupConfig_CloudFormationStack(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack" "test" {
+  name = %[1]q
+
+  template_body = <<JSON
+{
+  "Resources": {
+    "Topic": {
+      "Type": "AWS::SNS::Topic"
+    }
+  }
+}
+JSON
+}
+
+resource "aws_resourcegroups_group" "test" {
+  name = %[1]q
+
+  resource_query {
+    type = "CLOUDFORMATION_STACK_1_0"
+
+    query = <<JSON
+{
+  "ResourceTypeFilters": [
+    "AWS::AllSupported"
+  ],
+  "StackIdentifier": "${aws_cloudformation_stack.test.id}"
+}
+JSON
+  }
+}
+`, rName)
+}
@@ -249,6 +249,20 @@ func resourceAwsRouteCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 	log.Printf("[DEBUG] Route create config: %s", createOpts)
 
+	// CreateRoute succeeds for a route that already exists with the same target, which
+	// would leave the route managed by both this resource and another one (e.g. an
+	// inline route block of aws_route_table). Refuse to take it over silently.
+	routeTableID := d.Get("route_table_id").(string)
+	destinationCidrBlock := d.Get("destination_cidr_block").(string)
+	destinationIpv6CidrBlock := d.Get("destination_ipv6_cidr_block").(string)
+	if route, err := resourceAwsRouteFindRoute(conn, routeTableID, destinationCidrBlock, destinationIpv6CidrBlock); err == nil && route != nil {
+		destination := destinationCidrBlock
+		if destination == "" {
+			destination = destinationIpv6CidrBlock
+		}
+		return fmt.Errorf("route in Route Table (%s) with destination (%s) already exists, import it with: terraform import aws_route.<name> %s_%s", routeTableID, destination, routeTableID, destination)
+	}
+
 	// Create the route
 	var err error
 
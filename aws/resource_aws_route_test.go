@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -55,6 +56,20 @@ func TestAccAWSRoute_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSRoute_conflictsWithRouteTableRoute(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSRouteConfigConflictsWithRouteTableRoute,
+				ExpectError: regexp.MustCompile(`already exists`),
+			},
+		},
+	})
+}
+
 func TestAccAWSRoute_ipv6Support(t *testing.T) {
 	var route ec2.Route
 
@@ -505,6 +520,38 @@ resource "aws_route" "bar" {
 }
 `)
 
+var testAccAWSRouteConfigConflictsWithRouteTableRoute = fmt.Sprint(`
+resource "aws_vpc" "foo" {
+	cidr_block = "10.1.0.0/16"
+	tags = {
+		Name = "terraform-testacc-route-conflicts"
+	}
+}
+
+resource "aws_internet_gateway" "foo" {
+	vpc_id = "${aws_vpc.foo.id}"
+
+	tags = {
+		Name = "terraform-testacc-route-conflicts"
+	}
+}
+
+resource "aws_route_table" "foo" {
+	vpc_id = "${aws_vpc.foo.id}"
+
+	route {
+		cidr_block = "10.3.0.0/16"
+		gateway_id = "${aws_internet_gateway.foo.id}"
+	}
+}
+
+resource "aws_route" "bar" {
+	route_table_id = "${aws_route_table.foo.id}"
+	destination_cidr_block = "10.3.0.0/16"
+	gateway_id = "${aws_internet_gateway.foo.id}"
+}
+`)
+
 var testAccAWSRouteConfigIpv6InternetGateway = fmt.Sprintf(`
 resource "aws_vpc" "foo" {
   cidr_block = "10.1.0.0/16"
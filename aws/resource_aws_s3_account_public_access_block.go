@@ -21,6 +21,11 @@ func resourceAwsS3AccountPublicAccessBlock() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"account_id": {
 				Type:         schema.TypeString,
@@ -79,6 +84,10 @@ func resourceAwsS3AccountPublicAccessBlockCreate(d *schema.ResourceData, meta in
 
 	d.SetId(accountID)
 
+	if err := waitForS3AccountPublicAccessBlockConfiguration(conn, accountID, input.PublicAccessBlockConfiguration, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for S3 Account Public Access Block (%s) creation: %s", d.Id(), err)
+	}
+
 	return resourceAwsS3AccountPublicAccessBlockRead(d, meta)
 }
 
@@ -148,17 +157,11 @@ func resourceAwsS3AccountPublicAccessBlockUpdate(d *schema.ResourceData, meta in
 		return fmt.Errorf("error updating S3 Account Public Access Block (%s): %s", d.Id(), err)
 	}
 
-	// Workaround API eventual consistency issues. This type of logic should not normally be used.
-	// We cannot reliably determine when the Read after Update might be properly updated.
-	// Rather than introduce complicated retry logic, we presume that a lack of an update error
-	// means our update succeeded with our expected values.
-	d.Set("block_public_acls", input.PublicAccessBlockConfiguration.BlockPublicAcls)
-	d.Set("block_public_policy", input.PublicAccessBlockConfiguration.BlockPublicPolicy)
-	d.Set("ignore_public_acls", input.PublicAccessBlockConfiguration.IgnorePublicAcls)
-	d.Set("restrict_public_buckets", input.PublicAccessBlockConfiguration.RestrictPublicBuckets)
+	if err := waitForS3AccountPublicAccessBlockConfiguration(conn, d.Id(), input.PublicAccessBlockConfiguration, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error waiting for S3 Account Public Access Block (%s) update: %s", d.Id(), err)
+	}
 
-	// Skip normal Read after Update due to eventual consistency issues
-	return nil
+	return resourceAwsS3AccountPublicAccessBlockRead(d, meta)
 }
 
 func resourceAwsS3AccountPublicAccessBlockDelete(d *schema.ResourceData, meta interface{}) error {
@@ -180,3 +183,50 @@ func resourceAwsS3AccountPublicAccessBlockDelete(d *schema.ResourceData, meta in
 
 	return nil
 }
+
+func s3AccountPublicAccessBlockConfigurationRefreshFunc(conn *s3control.S3Control, accountID string, expected *s3control.PublicAccessBlockConfiguration) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.GetPublicAccessBlock(&s3control.GetPublicAccessBlockInput{
+			AccountId: aws.String(accountID),
+		})
+
+		if isAWSErr(err, s3control.ErrCodeNoSuchPublicAccessBlockConfiguration, "") {
+			return nil, "false", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil || output.PublicAccessBlockConfiguration == nil {
+			return nil, "false", nil
+		}
+
+		configuration := output.PublicAccessBlockConfiguration
+		matches := aws.BoolValue(configuration.BlockPublicAcls) == aws.BoolValue(expected.BlockPublicAcls) &&
+			aws.BoolValue(configuration.BlockPublicPolicy) == aws.BoolValue(expected.BlockPublicPolicy) &&
+			aws.BoolValue(configuration.IgnorePublicAcls) == aws.BoolValue(expected.IgnorePublicAcls) &&
+			aws.BoolValue(configuration.RestrictPublicBuckets) == aws.BoolValue(expected.RestrictPublicBuckets)
+
+		return configuration, fmt.Sprintf("%t", matches), nil
+	}
+}
+
+// waitForS3AccountPublicAccessBlockConfiguration waits until the account-level
+// configuration returned by the API consistently matches the expected one.
+// The API is eventually consistent and can return previous values for some time
+// after a successful PutPublicAccessBlock call.
+func waitForS3AccountPublicAccessBlockConfiguration(conn *s3control.S3Control, accountID string, expected *s3control.PublicAccessBlockConfiguration, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{"false"},
+		Target:                    []string{"true"},
+		Refresh:                   s3AccountPublicAccessBlockConfigurationRefreshFunc(conn, accountID, expected),
+		Timeout:                   timeout,
+		MinTimeout:                2 * time.Second,
+		ContinuousTargetOccurence: 5,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
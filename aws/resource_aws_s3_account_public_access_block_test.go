@@ -24,6 +24,10 @@ func TestAccAWSS3Account(t *testing.T) {
 			"IgnorePublicAcls":      testAccAWSS3AccountPublicAccessBlock_IgnorePublicAcls,
 			"RestrictPublicBuckets": testAccAWSS3AccountPublicAccessBlock_RestrictPublicBuckets,
 		},
+		"PublicAccessBlockDataSource": {
+			"basic":  testAccDataSourceAWSS3AccountPublicAccessBlock_basic,
+			"Bucket": testAccDataSourceAWSS3AccountPublicAccessBlock_Bucket,
+		},
 	}
 
 	for group, m := range testCases {
@@ -24,6 +24,8 @@ func resourceAwsS3BucketNotification() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceAwsS3BucketNotificationCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Type:     schema.TypeString,
@@ -470,3 +472,91 @@ func flattenLambdaFunctionConfigurations(configs []*s3.LambdaFunctionConfigurati
 
 	return lambdaFunctionNotifications
 }
+
+type s3BucketNotificationConfiguration struct {
+	address      string
+	events       []string
+	filterPrefix string
+	filterSuffix string
+}
+
+// resourceAwsS3BucketNotificationCustomizeDiff rejects configurations that
+// S3 would refuse on apply with "Configurations overlap", namely any two
+// notification configurations (of any destination type) that share an event
+// type and whose prefix and suffix filters can match the same object key.
+func resourceAwsS3BucketNotificationCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	var configs []s3BucketNotificationConfiguration
+
+	for _, notificationType := range []string{"topic", "queue", "lambda_function"} {
+		for i, raw := range diff.Get(notificationType).([]interface{}) {
+			address := fmt.Sprintf("%s.%d", notificationType, i)
+
+			// Skip configurations whose filters or events are not yet known.
+			if !diff.NewValueKnown(address+".events") || !diff.NewValueKnown(address+".filter_prefix") || !diff.NewValueKnown(address+".filter_suffix") {
+				continue
+			}
+
+			m, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			configs = append(configs, s3BucketNotificationConfiguration{
+				address:      address,
+				events:       aws.StringValueSlice(expandStringSet(m["events"].(*schema.Set))),
+				filterPrefix: m["filter_prefix"].(string),
+				filterSuffix: m["filter_suffix"].(string),
+			})
+		}
+	}
+
+	for i := 0; i < len(configs); i++ {
+		for j := i + 1; j < len(configs); j++ {
+			if event, ok := s3BucketNotificationConfigurationsOverlap(configs[i], configs[j]); ok {
+				return fmt.Errorf("notification configurations %s and %s overlap: both match event type %q with overlapping prefix/suffix filters", configs[i].address, configs[j].address, event)
+			}
+		}
+	}
+
+	return nil
+}
+
+// s3BucketNotificationConfigurationsOverlap returns the first shared event
+// type if both configurations can be triggered by the same object.
+func s3BucketNotificationConfigurationsOverlap(a, b s3BucketNotificationConfiguration) (string, bool) {
+	if !strings.HasPrefix(a.filterPrefix, b.filterPrefix) && !strings.HasPrefix(b.filterPrefix, a.filterPrefix) {
+		return "", false
+	}
+
+	if !strings.HasSuffix(a.filterSuffix, b.filterSuffix) && !strings.HasSuffix(b.filterSuffix, a.filterSuffix) {
+		return "", false
+	}
+
+	for _, eventA := range a.events {
+		for _, eventB := range b.events {
+			if s3NotificationEventsOverlap(eventA, eventB) {
+				return eventA, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// s3NotificationEventsOverlap handles wildcard event types, e.g.
+// s3:ObjectCreated:* overlaps s3:ObjectCreated:Put.
+func s3NotificationEventsOverlap(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	if strings.HasSuffix(a, "*") && strings.HasPrefix(b, strings.TrimSuffix(a, "*")) {
+		return true
+	}
+
+	if strings.HasSuffix(b, "*") && strings.HasPrefix(a, strings.TrimSuffix(b, "*")) {
+		return true
+	}
+
+	return false
+}
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"reflect"
+	"regexp"
 	"sort"
 	"testing"
 	"time"
@@ -260,6 +261,84 @@ func TestAccAWSS3BucketNotification_update(t *testing.T) {
 	})
 }
 
+func TestAccAWSS3BucketNotification_OverlappingConfigurations(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketNotificationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSS3BucketNotificationConfigOverlappingConfigurations(rName),
+				ExpectError: regexp.MustCompile(`notification configurations topic.0 and queue.0 overlap`),
+			},
+		},
+	})
+}
+
+func TestS3BucketNotificationConfigurationsOverlap(t *testing.T) {
+	cases := []struct {
+		Name     string
+		A        s3BucketNotificationConfiguration
+		B        s3BucketNotificationConfiguration
+		Expected bool
+	}{
+		{
+			Name:     "same event no filters",
+			A:        s3BucketNotificationConfiguration{events: []string{"s3:ObjectCreated:Put"}},
+			B:        s3BucketNotificationConfiguration{events: []string{"s3:ObjectCreated:Put"}},
+			Expected: true,
+		},
+		{
+			Name:     "different events",
+			A:        s3BucketNotificationConfiguration{events: []string{"s3:ObjectCreated:Put"}},
+			B:        s3BucketNotificationConfiguration{events: []string{"s3:ObjectRemoved:Delete"}},
+			Expected: false,
+		},
+		{
+			Name:     "wildcard event",
+			A:        s3BucketNotificationConfiguration{events: []string{"s3:ObjectCreated:*"}},
+			B:        s3BucketNotificationConfiguration{events: []string{"s3:ObjectRemoved:Delete", "s3:ObjectCreated:Copy"}},
+			Expected: true,
+		},
+		{
+			Name:     "nested prefixes",
+			A:        s3BucketNotificationConfiguration{events: []string{"s3:ObjectCreated:*"}, filterPrefix: "images/"},
+			B:        s3BucketNotificationConfiguration{events: []string{"s3:ObjectCreated:*"}, filterPrefix: "images/thumbnails/"},
+			Expected: true,
+		},
+		{
+			Name:     "disjoint prefixes",
+			A:        s3BucketNotificationConfiguration{events: []string{"s3:ObjectCreated:*"}, filterPrefix: "images/"},
+			B:        s3BucketNotificationConfiguration{events: []string{"s3:ObjectCreated:*"}, filterPrefix: "logs/"},
+			Expected: false,
+		},
+		{
+			Name:     "disjoint suffixes",
+			A:        s3BucketNotificationConfiguration{events: []string{"s3:ObjectCreated:*"}, filterSuffix: ".jpg"},
+			B:        s3BucketNotificationConfiguration{events: []string{"s3:ObjectCreated:*"}, filterSuffix: ".png"},
+			Expected: false,
+		},
+		{
+			Name:     "prefix and suffix only",
+			A:        s3BucketNotificationConfiguration{events: []string{"s3:ObjectCreated:*"}, filterPrefix: "images/"},
+			B:        s3BucketNotificationConfiguration{events: []string{"s3:ObjectCreated:*"}, filterSuffix: ".png"},
+			Expected: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, got := s3BucketNotificationConfigurationsOverlap(tc.A, tc.B)
+
+			if got != tc.Expected {
+				t.Errorf("got %t, expected %t", got, tc.Expected)
+			}
+		})
+	}
+}
+
 func testAccCheckAWSS3BucketNotificationDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).s3conn
 
@@ -712,3 +791,35 @@ resource "aws_s3_bucket_notification" "notification" {
 }
 `, rName)
 }
+
+func testAccAWSS3BucketNotificationConfigOverlappingConfigurations(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "topic" {
+  name = %[1]q
+}
+
+resource "aws_sqs_queue" "queue" {
+  name = %[1]q
+}
+
+resource "aws_s3_bucket" "bucket" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_notification" "notification" {
+  bucket = "${aws_s3_bucket.bucket.id}"
+
+  topic {
+    topic_arn     = "${aws_sns_topic.topic.arn}"
+    events        = ["s3:ObjectCreated:*"]
+    filter_prefix = "images/"
+  }
+
+  queue {
+    queue_arn     = "${aws_sqs_queue.queue.arn}"
+    events        = ["s3:ObjectCreated:Put"]
+    filter_suffix = ".png"
+  }
+}
+`, rName)
+}
@@ -0,0 +1,384 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsS3ObjectCopy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3ObjectCopyCreate,
+		Read:   resourceAwsS3ObjectCopyRead,
+		Update: resourceAwsS3ObjectCopyUpdate,
+		Delete: resourceAwsS3ObjectCopyDelete,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[^/]+/.+$`), "must be in the form BUCKET/KEY"),
+			},
+
+			"acl": {
+				Type:     schema.TypeString,
+				Default:  s3.ObjectCannedACLPrivate,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.ObjectCannedACLPrivate,
+					s3.ObjectCannedACLPublicRead,
+					s3.ObjectCannedACLPublicReadWrite,
+					s3.ObjectCannedACLAuthenticatedRead,
+					s3.ObjectCannedACLAwsExecRead,
+					s3.ObjectCannedACLBucketOwnerRead,
+					s3.ObjectCannedACLBucketOwnerFullControl,
+				}, false),
+			},
+
+			"cache_control": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"content_disposition": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"content_encoding": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"content_language": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"content_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"metadata": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Computed:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateS3ObjectMetadataIsLowerCase,
+			},
+
+			"metadata_directive": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.MetadataDirectiveCopy,
+					s3.MetadataDirectiveReplace,
+				}, false),
+			},
+
+			"tagging_directive": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.TaggingDirectiveCopy,
+					s3.TaggingDirectiveReplace,
+				}, false),
+			},
+
+			"storage_class": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.ObjectStorageClassStandard,
+					s3.ObjectStorageClassReducedRedundancy,
+					s3.ObjectStorageClassGlacier,
+					s3.ObjectStorageClassStandardIa,
+					s3.ObjectStorageClassOnezoneIa,
+					s3.ObjectStorageClassIntelligentTiering,
+					s3.ObjectStorageClassDeepArchive,
+				}, false),
+			},
+
+			"server_side_encryption": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.ServerSideEncryptionAes256,
+					s3.ServerSideEncryptionAwsKms,
+				}, false),
+			},
+
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"website_redirect": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"source_version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAwsS3ObjectCopyCreate(d *schema.ResourceData, meta interface{}) error {
+	if err := resourceAwsS3ObjectCopyDoCopy(d, meta); err != nil {
+		return err
+	}
+
+	d.SetId(d.Get("key").(string))
+
+	return resourceAwsS3ObjectCopyRead(d, meta)
+}
+
+func resourceAwsS3ObjectCopyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+
+	resp, err := conn.HeadObject(&s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+
+	if err != nil {
+		// If S3 returns a 404 Request Failure, mark the object as destroyed
+		if awsErr, ok := err.(awserr.RequestFailure); ok && awsErr.StatusCode() == 404 {
+			log.Printf("[WARN] S3 Object Copy (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading S3 Object Copy (%s): %s", d.Id(), err)
+	}
+
+	d.Set("cache_control", resp.CacheControl)
+	d.Set("content_disposition", resp.ContentDisposition)
+	d.Set("content_encoding", resp.ContentEncoding)
+	d.Set("content_language", resp.ContentLanguage)
+	d.Set("content_type", resp.ContentType)
+	d.Set("kms_key_id", resp.SSEKMSKeyId)
+	d.Set("server_side_encryption", resp.ServerSideEncryption)
+	d.Set("version_id", resp.VersionId)
+	d.Set("website_redirect", resp.WebsiteRedirectLocation)
+
+	// The Go SDK canonicalizes metadata keys from the response headers,
+	// e.g. x-amz-meta-my-key is returned as My-Key.
+	metadata := make(map[string]string, len(resp.Metadata))
+	for k, v := range resp.Metadata {
+		metadata[strings.ToLower(k)] = aws.StringValue(v)
+	}
+
+	if err := d.Set("metadata", metadata); err != nil {
+		return fmt.Errorf("error setting metadata: %s", err)
+	}
+
+	// See https://forums.aws.amazon.com/thread.jspa?threadID=44003
+	d.Set("etag", strings.Trim(aws.StringValue(resp.ETag), `"`))
+
+	// The "STANDARD" (which is also the default) storage
+	// class when set would not be included in the results.
+	d.Set("storage_class", s3.StorageClassStandard)
+	if resp.StorageClass != nil {
+		d.Set("storage_class", resp.StorageClass)
+	}
+
+	if err := getTagsS3Object(conn, d); err != nil {
+		return fmt.Errorf("error getting S3 Object Copy (%s) tags: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3ObjectCopyUpdate(d *schema.ResourceData, meta interface{}) error {
+	// Changes to any of these attributes require a new copy of the source object,
+	// which also applies the ACL. Tags are only copied with tagging_directive
+	// = "REPLACE", so they are always set after the copy.
+	copied := false
+	for _, key := range []string{
+		"source",
+		"cache_control",
+		"content_disposition",
+		"content_encoding",
+		"content_language",
+		"content_type",
+		"metadata",
+		"metadata_directive",
+		"storage_class",
+		"server_side_encryption",
+		"kms_key_id",
+		"tagging_directive",
+		"website_redirect",
+	} {
+		if d.HasChange(key) {
+			copied = true
+			break
+		}
+	}
+
+	conn := meta.(*AWSClient).s3conn
+
+	if copied {
+		if err := resourceAwsS3ObjectCopyDoCopy(d, meta); err != nil {
+			return err
+		}
+	} else if d.HasChange("acl") {
+		_, err := conn.PutObjectAcl(&s3.PutObjectAclInput{
+			Bucket: aws.String(d.Get("bucket").(string)),
+			Key:    aws.String(d.Get("key").(string)),
+			ACL:    aws.String(d.Get("acl").(string)),
+		})
+		if err != nil {
+			return fmt.Errorf("error updating S3 Object Copy (%s) ACL: %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsS3Object(conn, d); err != nil {
+		return fmt.Errorf("error updating S3 Object Copy (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsS3ObjectCopyRead(d, meta)
+}
+
+func resourceAwsS3ObjectCopyDelete(d *schema.ResourceData, meta interface{}) error {
+	return resourceAwsS3BucketObjectDelete(d, meta)
+}
+
+func resourceAwsS3ObjectCopyDoCopy(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+	source := d.Get("source").(string)
+
+	input := &s3.CopyObjectInput{
+		Bucket:     aws.String(bucket),
+		Key:        aws.String(key),
+		ACL:        aws.String(d.Get("acl").(string)),
+		CopySource: aws.String(url.QueryEscape(source)),
+	}
+
+	if v, ok := d.GetOk("cache_control"); ok {
+		input.CacheControl = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("content_disposition"); ok {
+		input.ContentDisposition = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("content_encoding"); ok {
+		input.ContentEncoding = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("content_language"); ok {
+		input.ContentLanguage = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("content_type"); ok {
+		input.ContentType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("metadata"); ok {
+		input.Metadata = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("metadata_directive"); ok {
+		input.MetadataDirective = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("storage_class"); ok {
+		input.StorageClass = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("server_side_encryption"); ok {
+		input.ServerSideEncryption = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.SSEKMSKeyId = aws.String(v.(string))
+		input.ServerSideEncryption = aws.String(s3.ServerSideEncryptionAwsKms)
+	}
+
+	if v, ok := d.GetOk("tagging_directive"); ok {
+		input.TaggingDirective = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		// The tag-set must be encoded as URL Query parameters.
+		input.Tagging = aws.String(keyvaluetags.New(v.(map[string]interface{})).UrlEncode())
+	}
+
+	if v, ok := d.GetOk("website_redirect"); ok {
+		input.WebsiteRedirectLocation = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Copying S3 Object: %s", input)
+	output, err := conn.CopyObject(input)
+
+	if err != nil {
+		return fmt.Errorf("error copying S3 Object (%s) to (%s/%s): %s", source, bucket, key, err)
+	}
+
+	d.Set("source_version_id", output.CopySourceVersionId)
+
+	return nil
+}
+
+func validateS3ObjectMetadataIsLowerCase(v interface{}, k string) (ws []string, errors []error) {
+	for key := range v.(map[string]interface{}) {
+		if key != strings.ToLower(key) {
+			errors = append(errors, fmt.Errorf("%q: S3 object metadata keys must be lowercase, got %q", k, key))
+		}
+	}
+
+	return
+}
@@ -0,0 +1,181 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSS3ObjectCopy_basic(t *testing.T) {
+	rName1 := acctest.RandomWithPrefix("tf-acc-test")
+	rName2 := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3_object_copy.test"
+	sourceKey := "WithoutPrefix"
+	key := "XWithoutPrefix"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3ObjectCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3ObjectCopyConfig_basic(rName1, sourceKey, rName2, key),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ObjectCopyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "bucket", rName2),
+					resource.TestCheckResourceAttr(resourceName, "key", key),
+					resource.TestCheckResourceAttr(resourceName, "source", fmt.Sprintf("%s/%s", rName1, sourceKey)),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3ObjectCopy_MetadataAndTags(t *testing.T) {
+	rName1 := acctest.RandomWithPrefix("tf-acc-test")
+	rName2 := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3_object_copy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3ObjectCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3ObjectCopyConfig_metadataAndTags(rName1, rName2, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ObjectCopyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "text/plain"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSS3ObjectCopyConfig_metadataAndTags(rName1, rName2, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ObjectCopyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.key1", "value2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3ObjectCopyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_object_copy" {
+			continue
+		}
+
+		_, err := conn.HeadObject(&s3.HeadObjectInput{
+			Bucket:  aws.String(rs.Primary.Attributes["bucket"]),
+			Key:     aws.String(rs.Primary.Attributes["key"]),
+			IfMatch: aws.String(rs.Primary.Attributes["etag"]),
+		})
+
+		if err == nil {
+			return fmt.Errorf("S3 Object Copy (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSS3ObjectCopyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Object Copy ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+		_, err := conn.HeadObject(&s3.HeadObjectInput{
+			Bucket:  aws.String(rs.Primary.Attributes["bucket"]),
+			Key:     aws.String(rs.Primary.Attributes["key"]),
+			IfMatch: aws.String(rs.Primary.Attributes["etag"]),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error reading S3 Object Copy (%s): %s", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSS3ObjectCopyConfig_basic(rName1, sourceKey, rName2, key string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "source" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket" "target" {
+  bucket = %[3]q
+}
+
+resource "aws_s3_bucket_object" "source" {
+  bucket  = "${aws_s3_bucket.source.bucket}"
+  key     = %[2]q
+  content = "Ingen ko på isen"
+}
+
+resource "aws_s3_object_copy" "test" {
+  bucket = "${aws_s3_bucket.target.bucket}"
+  key    = %[4]q
+  source = "${aws_s3_bucket.source.bucket}/${aws_s3_bucket_object.source.key}"
+}
+`, rName1, sourceKey, rName2, key)
+}
+
+func testAccAWSS3ObjectCopyConfig_metadataAndTags(rName1, rName2, value string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "source" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket" "target" {
+  bucket = %[2]q
+}
+
+resource "aws_s3_bucket_object" "source" {
+  bucket  = "${aws_s3_bucket.source.bucket}"
+  key     = "source"
+  content = "Ingen ko på isen"
+}
+
+resource "aws_s3_object_copy" "test" {
+  bucket             = "${aws_s3_bucket.target.bucket}"
+  key                = "target"
+  source             = "${aws_s3_bucket.source.bucket}/${aws_s3_bucket_object.source.key}"
+  content_type       = "text/plain"
+  metadata_directive = "REPLACE"
+  tagging_directive  = "REPLACE"
+
+  metadata = {
+    key1 = %[3]q
+  }
+
+  tags = {
+    Key1 = %[3]q
+  }
+}
+`, rName1, rName2, value)
+}
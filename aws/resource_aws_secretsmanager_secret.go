@@ -79,13 +79,15 @@ func resourceAwsSecretsManagerSecret() *schema.Resource {
 				Computed: true,
 			},
 			"rotation_lambda_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:       schema.TypeString,
+				Optional:   true,
+				Deprecated: "Use the aws_secretsmanager_secret_rotation resource instead",
 			},
 			"rotation_rules": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
+				Type:       schema.TypeList,
+				Optional:   true,
+				Deprecated: "Use the aws_secretsmanager_secret_rotation resource instead",
+				MaxItems:   1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"automatically_after_days": {
@@ -166,18 +168,7 @@ func resourceAwsSecretsManagerSecretCreate(d *schema.ResourceData, meta interfac
 		}
 
 		log.Printf("[DEBUG] Enabling Secrets Manager Secret rotation: %s", input)
-		err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-			_, err := conn.RotateSecret(input)
-			if err != nil {
-				// AccessDeniedException: Secrets Manager cannot invoke the specified Lambda function.
-				if isAWSErr(err, "AccessDeniedException", "") {
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
-		if err != nil {
+		if _, err := resourceAwsSecretsManagerSecretRotationRotate(conn, input, meta.(*AWSClient).eventualConsistencyTimeout); err != nil {
 			return fmt.Errorf("error enabling Secrets Manager Secret %q rotation: %s", d.Id(), err)
 		}
 	}
@@ -240,14 +231,20 @@ func resourceAwsSecretsManagerSecretRead(d *schema.ResourceData, meta interface{
 
 	d.Set("rotation_enabled", output.RotationEnabled)
 
-	if aws.BoolValue(output.RotationEnabled) {
-		d.Set("rotation_lambda_arn", output.RotationLambdaARN)
-		if err := d.Set("rotation_rules", flattenSecretsManagerRotationRules(output.RotationRules)); err != nil {
-			return fmt.Errorf("error setting rotation_rules: %s", err)
+	// The deprecated rotation arguments are only refreshed while they are
+	// configured on this resource, so that rotation managed by the
+	// aws_secretsmanager_secret_rotation resource does not show up as a diff
+	// that would cancel it.
+	if v, ok := d.GetOk("rotation_lambda_arn"); ok && v.(string) != "" {
+		if aws.BoolValue(output.RotationEnabled) {
+			d.Set("rotation_lambda_arn", output.RotationLambdaARN)
+			if err := d.Set("rotation_rules", flattenSecretsManagerRotationRules(output.RotationRules)); err != nil {
+				return fmt.Errorf("error setting rotation_rules: %s", err)
+			}
+		} else {
+			d.Set("rotation_lambda_arn", "")
+			d.Set("rotation_rules", []interface{}{})
 		}
-	} else {
-		d.Set("rotation_lambda_arn", "")
-		d.Set("rotation_rules", []interface{}{})
 	}
 
 	if err := d.Set("tags", tagsToMapSecretsManager(output.Tags)); err != nil {
@@ -315,18 +312,7 @@ func resourceAwsSecretsManagerSecretUpdate(d *schema.ResourceData, meta interfac
 			}
 
 			log.Printf("[DEBUG] Enabling Secrets Manager Secret rotation: %s", input)
-			err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-				_, err := conn.RotateSecret(input)
-				if err != nil {
-					// AccessDeniedException: Secrets Manager cannot invoke the specified Lambda function.
-					if isAWSErr(err, "AccessDeniedException", "") {
-						return resource.RetryableError(err)
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
-			if err != nil {
+			if _, err := resourceAwsSecretsManagerSecretRotationRotate(conn, input, meta.(*AWSClient).eventualConsistencyTimeout); err != nil {
 				return fmt.Errorf("error updating Secrets Manager Secret %q rotation: %s", d.Id(), err)
 			}
 		} else {
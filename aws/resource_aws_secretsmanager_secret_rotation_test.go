@@ -0,0 +1,164 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAwsSecretsManagerSecretRotation_Basic(t *testing.T) {
+	var secret secretsmanager.DescribeSecretOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_secretsmanager_secret_rotation.test"
+	lambdaFunctionResourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSecretsManagerSecretRotationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSecretsManagerSecretRotationConfig(rName, 7),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSecretsManagerSecretRotationExists(resourceName, &secret),
+					resource.TestCheckResourceAttr(resourceName, "rotation_enabled", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "rotation_lambda_arn", lambdaFunctionResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_rules.0.automatically_after_days", "7"),
+					resource.TestCheckResourceAttrPair(resourceName, "secret_id", "aws_secretsmanager_secret.test", "id"),
+				),
+			},
+			// Test updating rotation
+			// We need a valid rotation function for this testing
+			// InvalidRequestException: A previous rotation isn’t complete. That rotation will be reattempted.
+			/*
+				{
+					Config: testAccAwsSecretsManagerSecretRotationConfig(rName, 1),
+					Check: resource.ComposeTestCheckFunc(
+						testAccCheckAwsSecretsManagerSecretRotationExists(resourceName, &secret),
+						resource.TestCheckResourceAttr(resourceName, "rotation_enabled", "true"),
+						resource.TestCheckResourceAttr(resourceName, "rotation_rules.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "rotation_rules.0.automatically_after_days", "1"),
+					),
+				},
+			*/
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// The secret without the deprecated rotation arguments must not cancel this rotation
+			{
+				Config: testAccAwsSecretsManagerSecretRotationConfig(rName, 7),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSecretsManagerSecretRotationExists(resourceName, &secret),
+					resource.TestCheckResourceAttr(resourceName, "rotation_enabled", "true"),
+					resource.TestCheckResourceAttr("aws_secretsmanager_secret.test", "rotation_enabled", "true"),
+					resource.TestCheckResourceAttr("aws_secretsmanager_secret.test", "rotation_lambda_arn", ""),
+					resource.TestCheckResourceAttr("aws_secretsmanager_secret.test", "rotation_rules.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsSecretsManagerSecretRotationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).secretsmanagerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_secretsmanager_secret_rotation" {
+			continue
+		}
+
+		input := &secretsmanager.DescribeSecretInput{
+			SecretId: aws.String(rs.Primary.ID),
+		}
+
+		output, err := conn.DescribeSecret(input)
+
+		if err != nil {
+			if isAWSErr(err, secretsmanager.ErrCodeResourceNotFoundException, "") {
+				return nil
+			}
+			return err
+		}
+
+		if output != nil && output.DeletedDate == nil && aws.BoolValue(output.RotationEnabled) {
+			return fmt.Errorf("Secret %q rotation still enabled", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsSecretsManagerSecretRotationExists(resourceName string, secret *secretsmanager.DescribeSecretOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).secretsmanagerconn
+		input := &secretsmanager.DescribeSecretInput{
+			SecretId: aws.String(rs.Primary.ID),
+		}
+
+		output, err := conn.DescribeSecret(input)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("Secret %q does not exist", rs.Primary.ID)
+		}
+
+		if !aws.BoolValue(output.RotationEnabled) {
+			return fmt.Errorf("Secret %q rotation not enabled", rs.Primary.ID)
+		}
+
+		*secret = *output
+
+		return nil
+	}
+}
+
+func testAccAwsSecretsManagerSecretRotationConfig(rName string, automaticallyAfterDays int) string {
+	return baseAccAWSLambdaConfig(rName, rName, rName) + fmt.Sprintf(`
+# Not a real rotation function
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = "%[1]s"
+  handler       = "exports.example"
+  role          = "${aws_iam_role.iam_for_lambda.arn}"
+  runtime       = "nodejs8.10"
+}
+
+resource "aws_lambda_permission" "test" {
+  action         = "lambda:InvokeFunction"
+  function_name  = "${aws_lambda_function.test.function_name}"
+  principal      = "secretsmanager.amazonaws.com"
+  statement_id   = "AllowExecutionFromSecretsManager1"
+}
+
+resource "aws_secretsmanager_secret" "test" {
+  name = "%[1]s"
+}
+
+resource "aws_secretsmanager_secret_rotation" "test" {
+  secret_id           = "${aws_secretsmanager_secret.test.id}"
+  rotation_lambda_arn = "${aws_lambda_function.test.arn}"
+
+  rotation_rules {
+    automatically_after_days = %[2]d
+  }
+
+  depends_on = ["aws_lambda_permission.test"]
+}
+`, rName, automaticallyAfterDays)
+}
@@ -267,7 +267,7 @@ func TestAccAwsSecretsManagerSecret_RotationLambdaARN(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"recovery_window_in_days"},
+				ImportStateVerifyIgnore: []string{"recovery_window_in_days", "rotation_lambda_arn", "rotation_rules"},
 			},
 			// Test removing rotation on resource update
 			{
@@ -321,7 +321,7 @@ func TestAccAwsSecretsManagerSecret_RotationRules(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"recovery_window_in_days"},
+				ImportStateVerifyIgnore: []string{"recovery_window_in_days", "rotation_lambda_arn", "rotation_rules"},
 			},
 			// Test removing rotation rules on resource update
 			{
@@ -16,8 +16,15 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/semaphorekv"
 )
 
+// Rules are authorized and revoked individually, so a bounded number of rule
+// changes can safely proceed concurrently against the same security group.
+const awsSecurityGroupRuleConcurrency = 5
+
+var awsSecurityGroupRuleSemaphoreKV = semaphorekv.NewSemaphoreKV(awsSecurityGroupRuleConcurrency)
+
 func resourceAwsSecurityGroupRule() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsSecurityGroupRuleCreate,
@@ -148,8 +155,8 @@ func resourceAwsSecurityGroupRuleCreate(d *schema.ResourceData, meta interface{}
 	conn := meta.(*AWSClient).ec2conn
 	sg_id := d.Get("security_group_id").(string)
 
-	awsMutexKV.Lock(sg_id)
-	defer awsMutexKV.Unlock(sg_id)
+	awsSecurityGroupRuleSemaphoreKV.Acquire(sg_id)
+	defer awsSecurityGroupRuleSemaphoreKV.Release(sg_id)
 
 	sg, err := findResourceSecurityGroup(conn, sg_id)
 	if err != nil {
@@ -342,8 +349,8 @@ func resourceAwsSecurityGroupRuleDelete(d *schema.ResourceData, meta interface{}
 	conn := meta.(*AWSClient).ec2conn
 	sg_id := d.Get("security_group_id").(string)
 
-	awsMutexKV.Lock(sg_id)
-	defer awsMutexKV.Unlock(sg_id)
+	awsSecurityGroupRuleSemaphoreKV.Acquire(sg_id)
+	defer awsSecurityGroupRuleSemaphoreKV.Release(sg_id)
 
 	sg, err := findResourceSecurityGroup(conn, sg_id)
 	if err != nil {
@@ -856,8 +863,8 @@ func validateAwsSecurityGroupRule(d *schema.ResourceData) error {
 func resourceSecurityGroupRuleDescriptionUpdate(conn *ec2.EC2, d *schema.ResourceData) error {
 	sg_id := d.Get("security_group_id").(string)
 
-	awsMutexKV.Lock(sg_id)
-	defer awsMutexKV.Unlock(sg_id)
+	awsSecurityGroupRuleSemaphoreKV.Acquire(sg_id)
+	defer awsSecurityGroupRuleSemaphoreKV.Release(sg_id)
 
 	sg, err := findResourceSecurityGroup(conn, sg_id)
 	if err != nil {
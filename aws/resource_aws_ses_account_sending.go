@@ -0,0 +1,76 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsSesAccountSending() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSesAccountSendingUpdate,
+		Read:   resourceAwsSesAccountSendingRead,
+		Update: resourceAwsSesAccountSendingUpdate,
+		Delete: resourceAwsSesAccountSendingDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceAwsSesAccountSendingUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesConn
+
+	enabled := d.Get("enabled").(bool)
+	if err := resourceAwsSesAccountSendingSetEnabled(conn, enabled); err != nil {
+		return err
+	}
+
+	// Sending is a per-region account setting
+	d.SetId(meta.(*AWSClient).region)
+
+	return resourceAwsSesAccountSendingRead(d, meta)
+}
+
+func resourceAwsSesAccountSendingRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesConn
+
+	output, err := conn.GetAccountSendingEnabled(&ses.GetAccountSendingEnabledInput{})
+	if err != nil {
+		return fmt.Errorf("error reading SES account sending status: %s", err)
+	}
+
+	d.Set("enabled", aws.BoolValue(output.Enabled))
+
+	return nil
+}
+
+func resourceAwsSesAccountSendingDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesConn
+
+	// Sending is enabled by default, restore it when the resource is removed
+	return resourceAwsSesAccountSendingSetEnabled(conn, true)
+}
+
+func resourceAwsSesAccountSendingSetEnabled(conn *ses.SES, enabled bool) error {
+	input := &ses.UpdateAccountSendingEnabledInput{
+		Enabled: aws.Bool(enabled),
+	}
+
+	log.Printf("[DEBUG] Updating SES account sending status: %s", input)
+	if _, err := conn.UpdateAccountSendingEnabled(input); err != nil {
+		return fmt.Errorf("error updating SES account sending status: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// Sending is a per-region account setting, so these tests cannot run in parallel.
+func TestAccAWSSESAccountSending_basic(t *testing.T) {
+	resourceName := "aws_ses_account_sending.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSESAccountSendingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESAccountSendingConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESAccountSendingEnabled(false),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSESAccountSendingConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESAccountSendingEnabled(true),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsSESAccountSendingDestroy(s *terraform.State) error {
+	return testAccCheckAwsSESAccountSendingEnabled(true)(s)
+}
+
+func testAccCheckAwsSESAccountSendingEnabled(enabled bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).sesConn
+
+		output, err := conn.GetAccountSendingEnabled(&ses.GetAccountSendingEnabledInput{})
+		if err != nil {
+			return err
+		}
+
+		if aws.BoolValue(output.Enabled) != enabled {
+			return fmt.Errorf("SES account sending enabled is %t, expected %t", aws.BoolValue(output.Enabled), enabled)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSSESAccountSendingConfig(enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_ses_account_sending" "test" {
+  enabled = %t
+}
+`, enabled)
+}
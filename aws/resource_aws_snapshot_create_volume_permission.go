@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsSnapshotCreateVolumePermission() *schema.Resource {
@@ -24,9 +25,17 @@ func resourceAwsSnapshotCreateVolumePermission() *schema.Resource {
 				ForceNew: true,
 			},
 			"account_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"group"},
+			},
+			"group": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"account_id"},
+				ValidateFunc:  validation.StringInSlice([]string{ec2.PermissionGroupAll}, false),
 			},
 		},
 	}
@@ -37,7 +46,8 @@ func resourceAwsSnapshotCreateVolumePermissionExists(d *schema.ResourceData, met
 
 	snapshot_id := d.Get("snapshot_id").(string)
 	account_id := d.Get("account_id").(string)
-	return hasCreateVolumePermission(conn, snapshot_id, account_id)
+	group := d.Get("group").(string)
+	return hasCreateVolumePermission(conn, snapshot_id, account_id, group)
 }
 
 func resourceAwsSnapshotCreateVolumePermissionCreate(d *schema.ResourceData, meta interface{}) error {
@@ -45,13 +55,18 @@ func resourceAwsSnapshotCreateVolumePermissionCreate(d *schema.ResourceData, met
 
 	snapshot_id := d.Get("snapshot_id").(string)
 	account_id := d.Get("account_id").(string)
+	group := d.Get("group").(string)
+
+	if account_id == "" && group == "" {
+		return fmt.Errorf("one of account_id or group must be configured")
+	}
 
 	_, err := conn.ModifySnapshotAttribute(&ec2.ModifySnapshotAttributeInput{
 		SnapshotId: aws.String(snapshot_id),
 		Attribute:  aws.String("createVolumePermission"),
 		CreateVolumePermission: &ec2.CreateVolumePermissionModifications{
 			Add: []*ec2.CreateVolumePermission{
-				{UserId: aws.String(account_id)},
+				expandEc2CreateVolumePermission(account_id, group),
 			},
 		},
 	})
@@ -59,13 +74,17 @@ func resourceAwsSnapshotCreateVolumePermissionCreate(d *schema.ResourceData, met
 		return fmt.Errorf("Error adding snapshot createVolumePermission: %s", err)
 	}
 
-	d.SetId(fmt.Sprintf("%s-%s", snapshot_id, account_id))
+	if group != "" {
+		d.SetId(fmt.Sprintf("%s-%s", snapshot_id, group))
+	} else {
+		d.SetId(fmt.Sprintf("%s-%s", snapshot_id, account_id))
+	}
 
 	// Wait for the account to appear in the permission list
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"denied"},
 		Target:     []string{"granted"},
-		Refresh:    resourceAwsSnapshotCreateVolumePermissionStateRefreshFunc(conn, snapshot_id, account_id),
+		Refresh:    resourceAwsSnapshotCreateVolumePermissionStateRefreshFunc(conn, snapshot_id, account_id, group),
 		Timeout:    20 * time.Minute,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
@@ -88,13 +107,14 @@ func resourceAwsSnapshotCreateVolumePermissionDelete(d *schema.ResourceData, met
 
 	snapshot_id := d.Get("snapshot_id").(string)
 	account_id := d.Get("account_id").(string)
+	group := d.Get("group").(string)
 
 	_, err := conn.ModifySnapshotAttribute(&ec2.ModifySnapshotAttributeInput{
 		SnapshotId: aws.String(snapshot_id),
 		Attribute:  aws.String("createVolumePermission"),
 		CreateVolumePermission: &ec2.CreateVolumePermissionModifications{
 			Remove: []*ec2.CreateVolumePermission{
-				{UserId: aws.String(account_id)},
+				expandEc2CreateVolumePermission(account_id, group),
 			},
 		},
 	})
@@ -106,7 +126,7 @@ func resourceAwsSnapshotCreateVolumePermissionDelete(d *schema.ResourceData, met
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"granted"},
 		Target:     []string{"denied"},
-		Refresh:    resourceAwsSnapshotCreateVolumePermissionStateRefreshFunc(conn, snapshot_id, account_id),
+		Refresh:    resourceAwsSnapshotCreateVolumePermissionStateRefreshFunc(conn, snapshot_id, account_id, group),
 		Timeout:    5 * time.Minute,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
@@ -120,8 +140,8 @@ func resourceAwsSnapshotCreateVolumePermissionDelete(d *schema.ResourceData, met
 	return nil
 }
 
-func hasCreateVolumePermission(conn *ec2.EC2, snapshot_id string, account_id string) (bool, error) {
-	_, state, err := resourceAwsSnapshotCreateVolumePermissionStateRefreshFunc(conn, snapshot_id, account_id)()
+func hasCreateVolumePermission(conn *ec2.EC2, snapshot_id string, account_id string, group string) (bool, error) {
+	_, state, err := resourceAwsSnapshotCreateVolumePermissionStateRefreshFunc(conn, snapshot_id, account_id, group)()
 	if err != nil {
 		return false, err
 	}
@@ -132,7 +152,7 @@ func hasCreateVolumePermission(conn *ec2.EC2, snapshot_id string, account_id str
 	}
 }
 
-func resourceAwsSnapshotCreateVolumePermissionStateRefreshFunc(conn *ec2.EC2, snapshot_id string, account_id string) resource.StateRefreshFunc {
+func resourceAwsSnapshotCreateVolumePermissionStateRefreshFunc(conn *ec2.EC2, snapshot_id string, account_id string, group string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		attrs, err := conn.DescribeSnapshotAttribute(&ec2.DescribeSnapshotAttributeInput{
 			SnapshotId: aws.String(snapshot_id),
@@ -143,10 +163,21 @@ func resourceAwsSnapshotCreateVolumePermissionStateRefreshFunc(conn *ec2.EC2, sn
 		}
 
 		for _, vp := range attrs.CreateVolumePermissions {
-			if *vp.UserId == account_id {
+			if group != "" && aws.StringValue(vp.Group) == group {
+				return attrs, "granted", nil
+			}
+			if account_id != "" && aws.StringValue(vp.UserId) == account_id {
 				return attrs, "granted", nil
 			}
 		}
 		return attrs, "denied", nil
 	}
 }
+
+// expandEc2CreateVolumePermission returns a create volume permission for either an account or a group.
+func expandEc2CreateVolumePermission(account_id string, group string) *ec2.CreateVolumePermission {
+	if group != "" {
+		return &ec2.CreateVolumePermission{Group: aws.String(group)}
+	}
+	return &ec2.CreateVolumePermission{UserId: aws.String(account_id)}
+}
@@ -34,10 +34,29 @@ func TestAccAWSSnapshotCreateVolumePermission_Basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSSnapshotCreateVolumePermission_Group(t *testing.T) {
+	var snapshotId string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSnapshotCreateVolumePermissionConfigGroup(),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceGetAttr("aws_ebs_snapshot.example_snapshot", "id", &snapshotId),
+					testAccAWSSnapshotCreateVolumePermissionGroupExists(&snapshotId),
+					resource.TestCheckResourceAttr("aws_snapshot_create_volume_permission.test", "group", "all"),
+				),
+			},
+		},
+	})
+}
+
 func testAccAWSSnapshotCreateVolumePermissionExists(accountId, snapshotId *string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := testAccProvider.Meta().(*AWSClient).ec2conn
-		if has, err := hasCreateVolumePermission(conn, *snapshotId, *accountId); err != nil {
+		if has, err := hasCreateVolumePermission(conn, *snapshotId, *accountId, ""); err != nil {
 			return err
 		} else if !has {
 			return fmt.Errorf("create volume permission does not exist for '%s' on '%s'", *accountId, *snapshotId)
@@ -46,10 +65,22 @@ func testAccAWSSnapshotCreateVolumePermissionExists(accountId, snapshotId *strin
 	}
 }
 
+func testAccAWSSnapshotCreateVolumePermissionGroupExists(snapshotId *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		if has, err := hasCreateVolumePermission(conn, *snapshotId, "", "all"); err != nil {
+			return err
+		} else if !has {
+			return fmt.Errorf("create volume permission does not exist for group 'all' on '%s'", *snapshotId)
+		}
+		return nil
+	}
+}
+
 func testAccAWSSnapshotCreateVolumePermissionDestroyed(accountId, snapshotId *string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := testAccProvider.Meta().(*AWSClient).ec2conn
-		if has, err := hasCreateVolumePermission(conn, *snapshotId, *accountId); err != nil {
+		if has, err := hasCreateVolumePermission(conn, *snapshotId, *accountId, ""); err != nil {
 			return err
 		} else if has {
 			return fmt.Errorf("create volume permission still exists for '%s' on '%s'", *accountId, *snapshotId)
@@ -87,3 +118,27 @@ resource "aws_snapshot_create_volume_permission" "self-test" {
 }
 `, accountID)
 }
+
+func testAccAWSSnapshotCreateVolumePermissionConfigGroup() string {
+	return `
+data "aws_availability_zones" "available" {}
+
+resource "aws_ebs_volume" "example" {
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  size              = 1
+
+  tags = {
+    Name = "ebs_snap_perm_group"
+  }
+}
+
+resource "aws_ebs_snapshot" "example_snapshot" {
+  volume_id = "${aws_ebs_volume.example.id}"
+}
+
+resource "aws_snapshot_create_volume_permission" "test" {
+  snapshot_id = "${aws_ebs_snapshot.example_snapshot.id}"
+  group       = "all"
+}
+`
+}
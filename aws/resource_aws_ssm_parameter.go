@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"strings"
@@ -75,6 +76,12 @@ func resourceAwsSsmParameter() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"policies": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+			},
 			"tags": tagsSchema(),
 		},
 
@@ -151,6 +158,12 @@ func resourceAwsSsmParameterRead(d *schema.ResourceData, meta interface{}) error
 	}
 	d.Set("allowed_pattern", detail.AllowedPattern)
 
+	policies, err := flattenSsmParameterPolicies(detail.Policies)
+	if err != nil {
+		return fmt.Errorf("error flattening SSM Parameter (%s) policies: %s", d.Id(), err)
+	}
+	d.Set("policies", policies)
+
 	if tagList, err := ssmconn.ListTagsForResource(&ssm.ListTagsForResourceInput{
 		ResourceId:   aws.String(d.Get("name").(string)),
 		ResourceType: aws.String("Parameter"),
@@ -206,6 +219,13 @@ func resourceAwsSsmParameterPut(d *schema.ResourceData, meta interface{}) error
 		paramInput.Description = aws.String(n.(string))
 	}
 
+	if v, ok := d.GetOk("policies"); ok {
+		paramInput.Policies = aws.String(v.(string))
+	} else if d.HasChange("policies") {
+		// An empty list removes all existing policies
+		paramInput.Policies = aws.String("[]")
+	}
+
 	if keyID, ok := d.GetOk("key_id"); ok {
 		log.Printf("[DEBUG] Setting key_id for SSM Parameter %v: %s", d.Get("name"), keyID)
 		paramInput.SetKeyId(keyID.(string))
@@ -242,3 +262,30 @@ func shouldUpdateSsmParameter(d *schema.ResourceData) bool {
 	// if it is not a new resource, otherwise overwrite should be set to false.
 	return !d.IsNewResource()
 }
+
+// flattenSsmParameterPolicies returns the inline policies as the JSON array accepted by PutParameter.
+func flattenSsmParameterPolicies(policies []*ssm.ParameterInlinePolicy) (string, error) {
+	if len(policies) == 0 {
+		return "", nil
+	}
+
+	l := make([]interface{}, 0, len(policies))
+	for _, policy := range policies {
+		if policy == nil {
+			continue
+		}
+
+		var v interface{}
+		if err := json.Unmarshal([]byte(aws.StringValue(policy.PolicyText)), &v); err != nil {
+			return "", err
+		}
+		l = append(l, v)
+	}
+
+	b, err := json.Marshal(l)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
@@ -2,7 +2,9 @@ package aws
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -95,6 +97,43 @@ func TestAccAWSSSMParameter_Tier(t *testing.T) {
 	})
 }
 
+func TestAccAWSSSMParameter_Policies(t *testing.T) {
+	var parameter ssm.Parameter
+	rName := fmt.Sprintf("%s_%s", t.Name(), acctest.RandString(10))
+	resourceName := "aws_ssm_parameter.foo"
+	expiration := time.Now().UTC().Add(48 * time.Hour).Format("2006-01-02T15:04:05.000Z")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSMParameterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSMParameterConfigPolicies(rName, expiration),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMParameterExists(resourceName, &parameter),
+					resource.TestCheckResourceAttr(resourceName, "tier", "Advanced"),
+					resource.TestMatchResourceAttr(resourceName, "policies", regexp.MustCompile(`"Type":"Expiration"`)),
+					resource.TestMatchResourceAttr(resourceName, "policies", regexp.MustCompile(`"Type":"NoChangeNotification"`)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"overwrite"},
+			},
+			{
+				Config: testAccAWSSSMParameterConfigTier(rName, "Advanced"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMParameterExists(resourceName, &parameter),
+					resource.TestCheckResourceAttr(resourceName, "policies", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSSSMParameter_disappears(t *testing.T) {
 	var param ssm.Parameter
 	name := fmt.Sprintf("%s_%s", t.Name(), acctest.RandString(10))
@@ -423,6 +462,37 @@ resource "aws_ssm_parameter" "foo" {
 `, rName, tier)
 }
 
+func testAccAWSSSMParameterConfigPolicies(rName, expiration string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "foo" {
+  name  = %[1]q
+  tier  = "Advanced"
+  type  = "String"
+  value = "bar"
+
+  policies = <<POLICIES
+[
+  {
+    "Type": "Expiration",
+    "Version": "1.0",
+    "Attributes": {
+      "Timestamp": %[2]q
+    }
+  },
+  {
+    "Type": "NoChangeNotification",
+    "Version": "1.0",
+    "Attributes": {
+      "After": "20",
+      "Unit": "Days"
+    }
+  }
+]
+POLICIES
+}
+`, rName, expiration)
+}
+
 func testAccAWSSSMParameterBasicConfigTagsUpdated(rName, pType, value string) string {
 	return fmt.Sprintf(`
 resource "aws_ssm_parameter" "foo" {
@@ -525,3 +595,46 @@ func TestAWSSSMParameterShouldUpdate(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestFlattenSsmParameterPolicies(t *testing.T) {
+	testCases := []struct {
+		name     string
+		policies []*ssm.ParameterInlinePolicy
+		want     string
+	}{
+		{
+			name: "empty",
+			want: "",
+		},
+		{
+			name: "multiple",
+			policies: []*ssm.ParameterInlinePolicy{
+				{
+					PolicyStatus: aws.String("Pending"),
+					PolicyText:   aws.String(`{"Type":"Expiration","Version":"1.0","Attributes":{"Timestamp":"2020-12-02T21:34:33.000Z"}}`),
+					PolicyType:   aws.String("Expiration"),
+				},
+				{
+					PolicyStatus: aws.String("Pending"),
+					PolicyText:   aws.String(`{"Type":"NoChangeNotification","Version":"1.0","Attributes":{"After":"20","Unit":"Days"}}`),
+					PolicyType:   aws.String("NoChangeNotification"),
+				},
+			},
+			want: `[{"Attributes":{"Timestamp":"2020-12-02T21:34:33.000Z"},"Type":"Expiration","Version":"1.0"},{"Attributes":{"After":"20","Unit":"Days"},"Type":"NoChangeNotification","Version":"1.0"}]`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := flattenSsmParameterPolicies(testCase.policies)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("got %s, expected %s", got, testCase.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,322 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// Session Manager reads its regional preferences from this well-known Session document.
+const ssmSessionManagerPreferencesDocumentName = "SSM-SessionManagerRunShell"
+
+type ssmSessionManagerPreferencesContent struct {
+	SchemaVersion string                             `json:"schemaVersion"`
+	Description   string                             `json:"description"`
+	SessionType   string                             `json:"sessionType"`
+	Inputs        ssmSessionManagerPreferencesInputs `json:"inputs"`
+}
+
+type ssmSessionManagerPreferencesInputs struct {
+	S3BucketName                string                                    `json:"s3BucketName"`
+	S3KeyPrefix                 string                                    `json:"s3KeyPrefix"`
+	S3EncryptionEnabled         bool                                      `json:"s3EncryptionEnabled"`
+	CloudWatchLogGroupName      string                                    `json:"cloudWatchLogGroupName"`
+	CloudWatchEncryptionEnabled bool                                      `json:"cloudWatchEncryptionEnabled"`
+	KmsKeyId                    string                                    `json:"kmsKeyId"`
+	RunAsEnabled                bool                                      `json:"runAsEnabled"`
+	RunAsDefaultUser            string                                    `json:"runAsDefaultUser"`
+	IdleSessionTimeout          string                                    `json:"idleSessionTimeout,omitempty"`
+	ShellProfile                *ssmSessionManagerPreferencesShellProfile `json:"shellProfile,omitempty"`
+}
+
+type ssmSessionManagerPreferencesShellProfile struct {
+	Linux   string `json:"linux"`
+	Windows string `json:"windows"`
+}
+
+func resourceAwsSsmSessionManagerPreferences() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSsmSessionManagerPreferencesPut,
+		Read:   resourceAwsSsmSessionManagerPreferencesRead,
+		Update: resourceAwsSsmSessionManagerPreferencesPut,
+		Delete: resourceAwsSsmSessionManagerPreferencesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cloudwatch_encryption_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"cloudwatch_log_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"document_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"idle_session_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      20,
+				ValidateFunc: validation.IntBetween(1, 60),
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"run_as_default_user": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"run_as_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"s3_bucket_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"s3_encryption_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"s3_key_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"shell_profile": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"linux": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"windows": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsSsmSessionManagerPreferencesPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssmconn
+
+	content, err := json.Marshal(expandSsmSessionManagerPreferencesContent(d))
+	if err != nil {
+		return fmt.Errorf("error building SSM Session Manager Preferences document content: %s", err)
+	}
+
+	if d.IsNewResource() {
+		input := &ssm.CreateDocumentInput{
+			Content:        aws.String(string(content)),
+			DocumentFormat: aws.String(ssm.DocumentFormatJson),
+			DocumentType:   aws.String(ssm.DocumentTypeSession),
+			Name:           aws.String(ssmSessionManagerPreferencesDocumentName),
+		}
+
+		log.Printf("[DEBUG] Creating SSM Session Manager Preferences: %s", input)
+		_, err = conn.CreateDocument(input)
+
+		// The document is created with default preferences when Session Manager is first configured in the console
+		if isAWSErr(err, ssm.ErrCodeDocumentAlreadyExists, "") {
+			log.Printf("[DEBUG] SSM Session Manager Preferences document already exists, updating")
+			err = resourceAwsSsmSessionManagerPreferencesUpdateDocument(conn, string(content))
+		}
+	} else {
+		err = resourceAwsSsmSessionManagerPreferencesUpdateDocument(conn, string(content))
+	}
+
+	if err != nil {
+		return fmt.Errorf("error putting SSM Session Manager Preferences: %s", err)
+	}
+
+	d.SetId(ssmSessionManagerPreferencesDocumentName)
+
+	return resourceAwsSsmSessionManagerPreferencesRead(d, meta)
+}
+
+func resourceAwsSsmSessionManagerPreferencesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssmconn
+
+	input := &ssm.GetDocumentInput{
+		DocumentFormat: aws.String(ssm.DocumentFormatJson),
+		Name:           aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Reading SSM Session Manager Preferences: %s", input)
+	output, err := conn.GetDocument(input)
+
+	if isAWSErr(err, ssm.ErrCodeInvalidDocument, "") {
+		log.Printf("[WARN] SSM Session Manager Preferences (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SSM Session Manager Preferences (%s): %s", d.Id(), err)
+	}
+
+	var content ssmSessionManagerPreferencesContent
+	if err := json.Unmarshal([]byte(aws.StringValue(output.Content)), &content); err != nil {
+		return fmt.Errorf("error parsing SSM Session Manager Preferences (%s) document content: %s", d.Id(), err)
+	}
+
+	inputs := content.Inputs
+
+	idleSessionTimeout := 20
+	if inputs.IdleSessionTimeout != "" {
+		idleSessionTimeout, err = strconv.Atoi(inputs.IdleSessionTimeout)
+		if err != nil {
+			return fmt.Errorf("error parsing SSM Session Manager Preferences (%s) idle session timeout: %s", d.Id(), err)
+		}
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "ssm",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("document/%s", d.Id()),
+	}.String()
+
+	d.Set("arn", arn)
+	d.Set("cloudwatch_encryption_enabled", inputs.CloudWatchEncryptionEnabled)
+	d.Set("cloudwatch_log_group_name", inputs.CloudWatchLogGroupName)
+	d.Set("document_version", output.DocumentVersion)
+	d.Set("idle_session_timeout", idleSessionTimeout)
+	d.Set("kms_key_id", inputs.KmsKeyId)
+	d.Set("run_as_default_user", inputs.RunAsDefaultUser)
+	d.Set("run_as_enabled", inputs.RunAsEnabled)
+	d.Set("s3_bucket_name", inputs.S3BucketName)
+	d.Set("s3_encryption_enabled", inputs.S3EncryptionEnabled)
+	d.Set("s3_key_prefix", inputs.S3KeyPrefix)
+
+	if err := d.Set("shell_profile", flattenSsmSessionManagerPreferencesShellProfile(inputs.ShellProfile)); err != nil {
+		return fmt.Errorf("error setting shell_profile: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSsmSessionManagerPreferencesDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssmconn
+
+	input := &ssm.DeleteDocumentInput{
+		Name: aws.String(d.Id()),
+	}
+
+	// Session Manager falls back to its default preferences without the document
+	log.Printf("[DEBUG] Deleting SSM Session Manager Preferences: %s", input)
+	_, err := conn.DeleteDocument(input)
+
+	if isAWSErr(err, ssm.ErrCodeInvalidDocument, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SSM Session Manager Preferences (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsSsmSessionManagerPreferencesUpdateDocument(conn *ssm.SSM, content string) error {
+	input := &ssm.UpdateDocumentInput{
+		Content:         aws.String(content),
+		DocumentFormat:  aws.String(ssm.DocumentFormatJson),
+		DocumentVersion: aws.String("$LATEST"),
+		Name:            aws.String(ssmSessionManagerPreferencesDocumentName),
+	}
+
+	log.Printf("[DEBUG] Updating SSM Session Manager Preferences: %s", input)
+	output, err := conn.UpdateDocument(input)
+
+	var documentVersion string
+	if isAWSErr(err, ssm.ErrCodeDuplicateDocumentContent, "") {
+		log.Printf("[DEBUG] SSM Session Manager Preferences content is a duplicate of the latest version, updating default version only")
+		describeOutput, err := conn.DescribeDocument(&ssm.DescribeDocumentInput{
+			Name: aws.String(ssmSessionManagerPreferencesDocumentName),
+		})
+		if err != nil {
+			return err
+		}
+		documentVersion = aws.StringValue(describeOutput.Document.LatestVersion)
+	} else if err != nil {
+		return err
+	} else {
+		documentVersion = aws.StringValue(output.DocumentDescription.DocumentVersion)
+	}
+
+	_, err = conn.UpdateDocumentDefaultVersion(&ssm.UpdateDocumentDefaultVersionInput{
+		DocumentVersion: aws.String(documentVersion),
+		Name:            aws.String(ssmSessionManagerPreferencesDocumentName),
+	})
+
+	return err
+}
+
+func expandSsmSessionManagerPreferencesContent(d *schema.ResourceData) *ssmSessionManagerPreferencesContent {
+	content := &ssmSessionManagerPreferencesContent{
+		SchemaVersion: "1.0",
+		Description:   "Document to hold regional settings for Session Manager",
+		SessionType:   "Standard_Stream",
+		Inputs: ssmSessionManagerPreferencesInputs{
+			CloudWatchEncryptionEnabled: d.Get("cloudwatch_encryption_enabled").(bool),
+			CloudWatchLogGroupName:      d.Get("cloudwatch_log_group_name").(string),
+			IdleSessionTimeout:          strconv.Itoa(d.Get("idle_session_timeout").(int)),
+			KmsKeyId:                    d.Get("kms_key_id").(string),
+			RunAsDefaultUser:            d.Get("run_as_default_user").(string),
+			RunAsEnabled:                d.Get("run_as_enabled").(bool),
+			S3BucketName:                d.Get("s3_bucket_name").(string),
+			S3EncryptionEnabled:         d.Get("s3_encryption_enabled").(bool),
+			S3KeyPrefix:                 d.Get("s3_key_prefix").(string),
+		},
+	}
+
+	if l := d.Get("shell_profile").([]interface{}); len(l) > 0 && l[0] != nil {
+		m := l[0].(map[string]interface{})
+		content.Inputs.ShellProfile = &ssmSessionManagerPreferencesShellProfile{
+			Linux:   m["linux"].(string),
+			Windows: m["windows"].(string),
+		}
+	}
+
+	return content
+}
+
+func flattenSsmSessionManagerPreferencesShellProfile(shellProfile *ssmSessionManagerPreferencesShellProfile) []interface{} {
+	if shellProfile == nil || (shellProfile.Linux == "" && shellProfile.Windows == "") {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"linux":   shellProfile.Linux,
+		"windows": shellProfile.Windows,
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,151 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// Session Manager preferences are a single document per region, so these tests cannot run in parallel.
+func TestAccAWSSSMSessionManagerPreferences_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ssm_session_manager_preferences.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSMSessionManagerPreferencesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSMSessionManagerPreferencesConfig(rName, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMSessionManagerPreferencesExists(resourceName),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "ssm", fmt.Sprintf("document/%s", ssmSessionManagerPreferencesDocumentName)),
+					resource.TestCheckResourceAttrPair(resourceName, "cloudwatch_log_group_name", "aws_cloudwatch_log_group.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_encryption_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "idle_session_timeout", "20"),
+					resource.TestCheckResourceAttr(resourceName, "run_as_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "s3_bucket_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "s3_encryption_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "shell_profile.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "shell_profile.0.linux", "cd $HOME"),
+					resource.TestCheckResourceAttr(resourceName, "shell_profile.0.windows", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSSMSessionManagerPreferencesConfig(rName, 45),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMSessionManagerPreferencesExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "idle_session_timeout", "45"),
+				),
+			},
+		},
+	})
+}
+
+func TestExpandSsmSessionManagerPreferencesContent(t *testing.T) {
+	d := resourceAwsSsmSessionManagerPreferences().TestResourceData()
+	d.Set("cloudwatch_encryption_enabled", true)
+	d.Set("cloudwatch_log_group_name", "example")
+	d.Set("idle_session_timeout", 30)
+	d.Set("run_as_enabled", true)
+	d.Set("run_as_default_user", "ssm-user")
+	d.Set("s3_encryption_enabled", false)
+	d.Set("shell_profile", []interface{}{
+		map[string]interface{}{
+			"linux":   "bash",
+			"windows": "",
+		},
+	})
+
+	b, err := json.Marshal(expandSsmSessionManagerPreferencesContent(d))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := `{"schemaVersion":"1.0","description":"Document to hold regional settings for Session Manager","sessionType":"Standard_Stream","inputs":{"s3BucketName":"","s3KeyPrefix":"","s3EncryptionEnabled":false,"cloudWatchLogGroupName":"example","cloudWatchEncryptionEnabled":true,"kmsKeyId":"","runAsEnabled":true,"runAsDefaultUser":"ssm-user","idleSessionTimeout":"30","shellProfile":{"linux":"bash","windows":""}}}`
+
+	if got := string(b); got != want {
+		t.Errorf("got %s, expected %s", got, want)
+	}
+}
+
+func testAccCheckAWSSSMSessionManagerPreferencesExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ssmconn
+
+		output, err := conn.DescribeDocument(&ssm.DescribeDocumentInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.StringValue(output.Document.DocumentType); got != ssm.DocumentTypeSession {
+			return fmt.Errorf("SSM Document (%s) type is %s, expected %s", rs.Primary.ID, got, ssm.DocumentTypeSession)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSSSMSessionManagerPreferencesDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ssmconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssm_session_manager_preferences" {
+			continue
+		}
+
+		_, err := conn.DescribeDocument(&ssm.DescribeDocumentInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, ssm.ErrCodeInvalidDocument, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SSM Session Manager Preferences (%s) still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSSSMSessionManagerPreferencesConfig(rName string, idleSessionTimeout int) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_ssm_session_manager_preferences" "test" {
+  cloudwatch_encryption_enabled = false
+  cloudwatch_log_group_name     = "${aws_cloudwatch_log_group.test.name}"
+  idle_session_timeout          = %[2]d
+
+  shell_profile {
+    linux = "cd $HOME"
+  }
+}
+`, rName, idleSessionTimeout)
+}
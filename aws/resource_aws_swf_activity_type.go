@@ -0,0 +1,252 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/swf"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsSwfActivityType() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSwfActivityTypeCreate,
+		Read:   resourceAwsSwfActivityTypeRead,
+		Delete: resourceAwsSwfActivityTypeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		// Registered types are immutable, so every argument forces a new resource.
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"default_task_heartbeat_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSwfTimeout,
+			},
+			"default_task_list": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"default_task_priority": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"default_task_schedule_to_close_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSwfTimeout,
+			},
+			"default_task_schedule_to_start_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSwfTimeout,
+			},
+			"default_task_start_to_close_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSwfTimeout,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsSwfActivityTypeCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).swfconn
+
+	domain := d.Get("domain").(string)
+	name := d.Get("name").(string)
+	version := d.Get("version").(string)
+
+	input := &swf.RegisterActivityTypeInput{
+		Domain:  aws.String(domain),
+		Name:    aws.String(name),
+		Version: aws.String(version),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_heartbeat_timeout"); ok {
+		input.DefaultTaskHeartbeatTimeout = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_list"); ok {
+		input.DefaultTaskList = &swf.TaskList{
+			Name: aws.String(v.(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("default_task_priority"); ok {
+		input.DefaultTaskPriority = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_schedule_to_close_timeout"); ok {
+		input.DefaultTaskScheduleToCloseTimeout = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_schedule_to_start_timeout"); ok {
+		input.DefaultTaskScheduleToStartTimeout = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_start_to_close_timeout"); ok {
+		input.DefaultTaskStartToCloseTimeout = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Registering SWF Activity Type: %s", input)
+	_, err := conn.RegisterActivityType(input)
+
+	if isAWSErr(err, swf.ErrCodeTypeAlreadyExistsFault, "") {
+		// Deprecated types still count as existing and can never be registered again.
+		return fmt.Errorf("error registering SWF Activity Type (%s/%s) in domain %s: a registered or deprecated type with this name and version already exists, use a new version: %s", name, version, domain, err)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error registering SWF Activity Type (%s/%s) in domain %s: %s", name, version, domain, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s:%s", domain, name, version))
+
+	return resourceAwsSwfActivityTypeRead(d, meta)
+}
+
+func resourceAwsSwfActivityTypeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).swfconn
+
+	domain, name, version, err := decodeSwfTypeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.DescribeActivityType(&swf.DescribeActivityTypeInput{
+		Domain: aws.String(domain),
+		ActivityType: &swf.ActivityType{
+			Name:    aws.String(name),
+			Version: aws.String(version),
+		},
+	})
+
+	if isAWSErr(err, swf.ErrCodeUnknownResourceFault, "") {
+		log.Printf("[WARN] SWF Activity Type (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SWF Activity Type (%s): %s", d.Id(), err)
+	}
+
+	if resp == nil || resp.Configuration == nil || resp.TypeInfo == nil {
+		log.Printf("[WARN] SWF Activity Type (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if aws.StringValue(resp.TypeInfo.Status) == swf.RegistrationStatusDeprecated {
+		log.Printf("[WARN] SWF Activity Type (%s) is deprecated, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	config := resp.Configuration
+
+	d.Set("domain", domain)
+	d.Set("name", resp.TypeInfo.ActivityType.Name)
+	d.Set("version", resp.TypeInfo.ActivityType.Version)
+	d.Set("description", resp.TypeInfo.Description)
+	d.Set("status", resp.TypeInfo.Status)
+	d.Set("creation_date", aws.TimeValue(resp.TypeInfo.CreationDate).Format(time.RFC3339))
+	d.Set("default_task_heartbeat_timeout", config.DefaultTaskHeartbeatTimeout)
+	d.Set("default_task_priority", config.DefaultTaskPriority)
+	d.Set("default_task_schedule_to_close_timeout", config.DefaultTaskScheduleToCloseTimeout)
+	d.Set("default_task_schedule_to_start_timeout", config.DefaultTaskScheduleToStartTimeout)
+	d.Set("default_task_start_to_close_timeout", config.DefaultTaskStartToCloseTimeout)
+
+	d.Set("default_task_list", "")
+	if config.DefaultTaskList != nil {
+		d.Set("default_task_list", config.DefaultTaskList.Name)
+	}
+
+	return nil
+}
+
+func resourceAwsSwfActivityTypeDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).swfconn
+
+	domain, name, version, err := decodeSwfTypeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deprecating SWF Activity Type: %s", d.Id())
+	_, err = conn.DeprecateActivityType(&swf.DeprecateActivityTypeInput{
+		Domain: aws.String(domain),
+		ActivityType: &swf.ActivityType{
+			Name:    aws.String(name),
+			Version: aws.String(version),
+		},
+	})
+
+	if isAWSErr(err, swf.ErrCodeTypeDeprecatedFault, "") || isAWSErr(err, swf.ErrCodeUnknownResourceFault, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deprecating SWF Activity Type (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// decodeSwfTypeID splits an activity or workflow type ID into its domain, name and version.
+// SWF names and versions cannot contain colons.
+func decodeSwfTypeID(id string) (string, string, string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("expected ID in format DOMAIN:NAME:VERSION, received: %s", id)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
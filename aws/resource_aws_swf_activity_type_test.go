@@ -0,0 +1,191 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/swf"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSSwfActivityType_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_swf_activity_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckSwfDomainTestingEnabled(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSwfActivityTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSwfActivityTypeConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAwsSwfActivityTypeExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "domain", "aws_swf_domain.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "version", "1.0"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "status", swf.RegistrationStatusRegistered),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSwfActivityType_Defaults(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_swf_activity_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckSwfDomainTestingEnabled(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSwfActivityTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSwfActivityTypeConfig_Defaults(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAwsSwfActivityTypeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Terraform acceptance test"),
+					resource.TestCheckResourceAttr(resourceName, "default_task_heartbeat_timeout", "300"),
+					resource.TestCheckResourceAttr(resourceName, "default_task_list", "tf-acc-test"),
+					resource.TestCheckResourceAttr(resourceName, "default_task_priority", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_task_schedule_to_close_timeout", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, "default_task_schedule_to_start_timeout", "60"),
+					resource.TestCheckResourceAttr(resourceName, "default_task_start_to_close_timeout", "600"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsSwfActivityTypeDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).swfconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_swf_activity_type" {
+			continue
+		}
+
+		domain, name, version, err := decodeSwfTypeID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := conn.DescribeActivityType(&swf.DescribeActivityTypeInput{
+			Domain: aws.String(domain),
+			ActivityType: &swf.ActivityType{
+				Name:    aws.String(name),
+				Version: aws.String(version),
+			},
+		})
+
+		if isAWSErr(err, swf.ErrCodeUnknownResourceFault, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if status := aws.StringValue(resp.TypeInfo.Status); status != swf.RegistrationStatusDeprecated {
+			return fmt.Errorf("SWF Activity Type (%s) status is %s instead of %s", rs.Primary.ID, status, swf.RegistrationStatusDeprecated)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsSwfActivityTypeExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SWF Activity Type ID is set")
+		}
+
+		domain, name, version, err := decodeSwfTypeID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).swfconn
+
+		resp, err := conn.DescribeActivityType(&swf.DescribeActivityTypeInput{
+			Domain: aws.String(domain),
+			ActivityType: &swf.ActivityType{
+				Name:    aws.String(name),
+				Version: aws.String(version),
+			},
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if status := aws.StringValue(resp.TypeInfo.Status); status != swf.RegistrationStatusRegistered {
+			return fmt.Errorf("SWF Activity Type (%s) status is %s instead of %s", rs.Primary.ID, status, swf.RegistrationStatusRegistered)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSSwfActivityTypeConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_swf_domain" "test" {
+  name                                        = %[1]q
+  workflow_execution_retention_period_in_days = 1
+}
+
+resource "aws_swf_activity_type" "test" {
+  domain  = "${aws_swf_domain.test.name}"
+  name    = %[1]q
+  version = "1.0"
+}
+`, rName)
+}
+
+func testAccAWSSwfActivityTypeConfig_Defaults(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_swf_domain" "test" {
+  name                                        = %[1]q
+  workflow_execution_retention_period_in_days = 1
+}
+
+resource "aws_swf_activity_type" "test" {
+  domain                                 = "${aws_swf_domain.test.name}"
+  name                                   = %[1]q
+  version                                = "1.0"
+  description                            = "Terraform acceptance test"
+  default_task_heartbeat_timeout         = "300"
+  default_task_list                      = "tf-acc-test"
+  default_task_priority                  = "1"
+  default_task_schedule_to_close_timeout = "NONE"
+  default_task_schedule_to_start_timeout = "60"
+  default_task_start_to_close_timeout    = "600"
+}
+`, rName)
+}
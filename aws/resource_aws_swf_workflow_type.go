@@ -0,0 +1,244 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/swf"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsSwfWorkflowType() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSwfWorkflowTypeCreate,
+		Read:   resourceAwsSwfWorkflowTypeRead,
+		Delete: resourceAwsSwfWorkflowTypeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		// Registered types are immutable, so every argument forces a new resource.
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"default_child_policy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					swf.ChildPolicyAbandon,
+					swf.ChildPolicyRequestCancel,
+					swf.ChildPolicyTerminate,
+				}, false),
+			},
+			"default_execution_start_to_close_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSwfTimeout,
+			},
+			"default_lambda_role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"default_task_list": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"default_task_priority": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"default_task_start_to_close_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSwfTimeout,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsSwfWorkflowTypeCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).swfconn
+
+	domain := d.Get("domain").(string)
+	name := d.Get("name").(string)
+	version := d.Get("version").(string)
+
+	input := &swf.RegisterWorkflowTypeInput{
+		Domain:  aws.String(domain),
+		Name:    aws.String(name),
+		Version: aws.String(version),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_child_policy"); ok {
+		input.DefaultChildPolicy = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_execution_start_to_close_timeout"); ok {
+		input.DefaultExecutionStartToCloseTimeout = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_lambda_role"); ok {
+		input.DefaultLambdaRole = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_list"); ok {
+		input.DefaultTaskList = &swf.TaskList{
+			Name: aws.String(v.(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("default_task_priority"); ok {
+		input.DefaultTaskPriority = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_start_to_close_timeout"); ok {
+		input.DefaultTaskStartToCloseTimeout = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Registering SWF Workflow Type: %s", input)
+	_, err := conn.RegisterWorkflowType(input)
+
+	if isAWSErr(err, swf.ErrCodeTypeAlreadyExistsFault, "") {
+		// Deprecated types still count as existing and can never be registered again.
+		return fmt.Errorf("error registering SWF Workflow Type (%s/%s) in domain %s: a registered or deprecated type with this name and version already exists, use a new version: %s", name, version, domain, err)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error registering SWF Workflow Type (%s/%s) in domain %s: %s", name, version, domain, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s:%s", domain, name, version))
+
+	return resourceAwsSwfWorkflowTypeRead(d, meta)
+}
+
+func resourceAwsSwfWorkflowTypeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).swfconn
+
+	domain, name, version, err := decodeSwfTypeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.DescribeWorkflowType(&swf.DescribeWorkflowTypeInput{
+		Domain: aws.String(domain),
+		WorkflowType: &swf.WorkflowType{
+			Name:    aws.String(name),
+			Version: aws.String(version),
+		},
+	})
+
+	if isAWSErr(err, swf.ErrCodeUnknownResourceFault, "") {
+		log.Printf("[WARN] SWF Workflow Type (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SWF Workflow Type (%s): %s", d.Id(), err)
+	}
+
+	if resp == nil || resp.Configuration == nil || resp.TypeInfo == nil {
+		log.Printf("[WARN] SWF Workflow Type (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if aws.StringValue(resp.TypeInfo.Status) == swf.RegistrationStatusDeprecated {
+		log.Printf("[WARN] SWF Workflow Type (%s) is deprecated, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	config := resp.Configuration
+
+	d.Set("domain", domain)
+	d.Set("name", resp.TypeInfo.WorkflowType.Name)
+	d.Set("version", resp.TypeInfo.WorkflowType.Version)
+	d.Set("description", resp.TypeInfo.Description)
+	d.Set("status", resp.TypeInfo.Status)
+	d.Set("creation_date", aws.TimeValue(resp.TypeInfo.CreationDate).Format(time.RFC3339))
+	d.Set("default_child_policy", config.DefaultChildPolicy)
+	d.Set("default_execution_start_to_close_timeout", config.DefaultExecutionStartToCloseTimeout)
+	d.Set("default_lambda_role", config.DefaultLambdaRole)
+	d.Set("default_task_priority", config.DefaultTaskPriority)
+	d.Set("default_task_start_to_close_timeout", config.DefaultTaskStartToCloseTimeout)
+
+	d.Set("default_task_list", "")
+	if config.DefaultTaskList != nil {
+		d.Set("default_task_list", config.DefaultTaskList.Name)
+	}
+
+	return nil
+}
+
+func resourceAwsSwfWorkflowTypeDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).swfconn
+
+	domain, name, version, err := decodeSwfTypeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deprecating SWF Workflow Type: %s", d.Id())
+	_, err = conn.DeprecateWorkflowType(&swf.DeprecateWorkflowTypeInput{
+		Domain: aws.String(domain),
+		WorkflowType: &swf.WorkflowType{
+			Name:    aws.String(name),
+			Version: aws.String(version),
+		},
+	})
+
+	if isAWSErr(err, swf.ErrCodeTypeDeprecatedFault, "") || isAWSErr(err, swf.ErrCodeUnknownResourceFault, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deprecating SWF Workflow Type (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/swf"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSSwfWorkflowType_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_swf_workflow_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckSwfDomainTestingEnabled(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSwfWorkflowTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSwfWorkflowTypeConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAwsSwfWorkflowTypeExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "domain", "aws_swf_domain.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "version", "1.0"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "status", swf.RegistrationStatusRegistered),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSwfWorkflowType_Defaults(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_swf_workflow_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckSwfDomainTestingEnabled(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSwfWorkflowTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSwfWorkflowTypeConfig_Defaults(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAwsSwfWorkflowTypeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Terraform acceptance test"),
+					resource.TestCheckResourceAttr(resourceName, "default_child_policy", "TERMINATE"),
+					resource.TestCheckResourceAttr(resourceName, "default_execution_start_to_close_timeout", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "default_task_list", "tf-acc-test"),
+					resource.TestCheckResourceAttr(resourceName, "default_task_priority", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_task_start_to_close_timeout", "600"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsSwfWorkflowTypeDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).swfconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_swf_workflow_type" {
+			continue
+		}
+
+		domain, name, version, err := decodeSwfTypeID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := conn.DescribeWorkflowType(&swf.DescribeWorkflowTypeInput{
+			Domain: aws.String(domain),
+			WorkflowType: &swf.WorkflowType{
+				Name:    aws.String(name),
+				Version: aws.String(version),
+			},
+		})
+
+		if isAWSErr(err, swf.ErrCodeUnknownResourceFault, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if status := aws.StringValue(resp.TypeInfo.Status); status != swf.RegistrationStatusDeprecated {
+			return fmt.Errorf("SWF Workflow Type (%s) status is %s instead of %s", rs.Primary.ID, status, swf.RegistrationStatusDeprecated)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsSwfWorkflowTypeExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SWF Workflow Type ID is set")
+		}
+
+		domain, name, version, err := decodeSwfTypeID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).swfconn
+
+		resp, err := conn.DescribeWorkflowType(&swf.DescribeWorkflowTypeInput{
+			Domain: aws.String(domain),
+			WorkflowType: &swf.WorkflowType{
+				Name:    aws.String(name),
+				Version: aws.String(version),
+			},
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if status := aws.StringValue(resp.TypeInfo.Status); status != swf.RegistrationStatusRegistered {
+			return fmt.Errorf("SWF Workflow Type (%s) status is %s instead of %s", rs.Primary.ID, status, swf.RegistrationStatusRegistered)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSSwfWorkflowTypeConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_swf_domain" "test" {
+  name                                        = %[1]q
+  workflow_execution_retention_period_in_days = 1
+}
+
+resource "aws_swf_workflow_type" "test" {
+  domain  = "${aws_swf_domain.test.name}"
+  name    = %[1]q
+  version = "1.0"
+}
+`, rName)
+}
+
+func testAccAWSSwfWorkflowTypeConfig_Defaults(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_swf_domain" "test" {
+  name                                        = %[1]q
+  workflow_execution_retention_period_in_days = 1
+}
+
+resource "aws_swf_workflow_type" "test" {
+  domain                                   = "${aws_swf_domain.test.name}"
+  name                                     = %[1]q
+  version                                  = "1.0"
+  description                              = "Terraform acceptance test"
+  default_child_policy                     = "TERMINATE"
+  default_execution_start_to_close_timeout = "3600"
+  default_task_list                        = "tf-acc-test"
+  default_task_priority                    = "1"
+  default_task_start_to_close_timeout      = "600"
+}
+`, rName)
+}
@@ -0,0 +1,122 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSVpcEndpointPolicy_basic(t *testing.T) {
+	var endpoint ec2.VpcEndpoint
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_vpc_endpoint_policy.test"
+	// This policy checks the DiffSuppressFunc
+	policy1 := `
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "ReadOnly",
+      "Principal": "*",
+      "Action": [
+        "s3:GetObject"
+      ],
+      "Effect": "Allow",
+      "Resource": [
+        "*"
+      ]
+    }
+  ]
+}
+`
+	policy2 := `
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "ReadWrite",
+    "Effect": "Allow",
+    "Principal": "*",
+    "Action": ["s3:GetObject", "s3:PutObject"],
+    "Resource": "*"
+  }]
+}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcEndpointPolicyConfig(rName, policy1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcEndpointExists("aws_vpc_endpoint.test", &endpoint),
+					testAccCheckVpcEndpointPolicySid(&endpoint, "ReadOnly"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_endpoint_id", "aws_vpc_endpoint.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVpcEndpointPolicyConfig(rName, policy2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcEndpointExists("aws_vpc_endpoint.test", &endpoint),
+					testAccCheckVpcEndpointPolicySid(&endpoint, "ReadWrite"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVpcEndpointPolicySid(endpoint *ec2.VpcEndpoint, sid string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		expected := fmt.Sprintf(`"Sid":%q`, sid)
+		policy, err := structure.NormalizeJsonString(aws.StringValue(endpoint.PolicyDocument))
+
+		if err != nil {
+			return err
+		}
+
+		if !strings.Contains(policy, expected) {
+			return fmt.Errorf("VPC Endpoint (%s) policy %s does not contain %s", aws.StringValue(endpoint.VpcEndpointId), policy, expected)
+		}
+
+		return nil
+	}
+}
+
+func testAccVpcEndpointPolicyConfig(rName, policy string) string {
+	return fmt.Sprintf(`
+data "aws_vpc_endpoint_service" "s3" {
+  service = "s3"
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_endpoint" "test" {
+  service_name = "${data.aws_vpc_endpoint_service.s3.service_name}"
+  vpc_id       = "${aws_vpc.test.id}"
+}
+
+resource "aws_vpc_endpoint_policy" "test" {
+  vpc_endpoint_id = "${aws_vpc_endpoint.test.id}"
+  policy          = <<POLICY%[2]sPOLICY
+}
+`, rName, policy)
+}
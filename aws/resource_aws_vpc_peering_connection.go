@@ -12,6 +12,12 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
+// vpcPeeringConnectionOptionsEventualConsistencyErrors are returned until a
+// freshly accepted connection is active and its options can be set.
+var vpcPeeringConnectionOptionsEventualConsistencyErrors = []awsEventualConsistencyError{
+	{code: "OperationNotPermitted", message: "is not active"},
+}
+
 func resourceAwsVpcPeeringConnection() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsVPCPeeringCreate,
@@ -195,25 +201,37 @@ func resourceVPCPeeringConnectionAccept(conn *ec2.EC2, id string) (string, error
 	return *pc.Status.Code, nil
 }
 
-func resourceAwsVpcPeeringConnectionModifyOptions(d *schema.ResourceData, meta interface{}) error {
+func resourceAwsVpcPeeringConnectionModifyOptions(d *schema.ResourceData, meta interface{}, crossRegionPeering bool) error {
 	conn := meta.(*AWSClient).ec2conn
 
 	req := &ec2.ModifyVpcPeeringConnectionOptionsInput{
 		VpcPeeringConnectionId: aws.String(d.Id()),
 	}
 
-	v := d.Get("accepter").(*schema.Set).List()
-	if len(v) > 0 {
-		req.AccepterPeeringConnectionOptions = expandVpcPeeringConnectionOptions(v[0].(map[string]interface{}))
+	// Only send the changed side: for a cross-region VPC Peering Connection each side's
+	// options can only be modified from that side's region, e.g. via a provider alias.
+	if d.HasChange("accepter") {
+		v := d.Get("accepter").(*schema.Set).List()
+		if len(v) > 0 {
+			req.AccepterPeeringConnectionOptions = expandVpcPeeringConnectionOptions(v[0].(map[string]interface{}), crossRegionPeering)
+		}
 	}
 
-	v = d.Get("requester").(*schema.Set).List()
-	if len(v) > 0 {
-		req.RequesterPeeringConnectionOptions = expandVpcPeeringConnectionOptions(v[0].(map[string]interface{}))
+	if d.HasChange("requester") {
+		v := d.Get("requester").(*schema.Set).List()
+		if len(v) > 0 {
+			req.RequesterPeeringConnectionOptions = expandVpcPeeringConnectionOptions(v[0].(map[string]interface{}), crossRegionPeering)
+		}
+	}
+
+	if req.AccepterPeeringConnectionOptions == nil && req.RequesterPeeringConnectionOptions == nil {
+		return nil
 	}
 
 	log.Printf("[DEBUG] Modifying VPC Peering Connection options: %#v", req)
-	_, err := conn.ModifyVpcPeeringConnectionOptions(req)
+	_, err := retryOnAwsEventualConsistencyErrors(meta.(*AWSClient).eventualConsistencyTimeout, func() (interface{}, error) {
+		return conn.ModifyVpcPeeringConnectionOptions(req)
+	}, vpcPeeringConnectionOptionsEventualConsistencyErrors...)
 
 	return err
 }
@@ -258,7 +276,7 @@ func resourceAwsVPCPeeringUpdate(d *schema.ResourceData, meta interface{}) error
 				"or activate VPC Peering Connection manually.", d.Id())
 		}
 
-		if err := resourceAwsVpcPeeringConnectionModifyOptions(d, meta); err != nil {
+		if err := resourceAwsVpcPeeringConnectionModifyOptions(d, meta, vpcPeeringConnectionIsCrossRegion(pc)); err != nil {
 			return fmt.Errorf("Error modifying VPC Peering Connection options: %s", err)
 		}
 	}
@@ -374,6 +392,14 @@ func vpcPeeringConnectionOptionsSchema() *schema.Schema {
 	}
 }
 
+func vpcPeeringConnectionIsCrossRegion(pc *ec2.VpcPeeringConnection) bool {
+	if pc == nil || pc.AccepterVpcInfo == nil || pc.RequesterVpcInfo == nil {
+		return false
+	}
+
+	return aws.StringValue(pc.AccepterVpcInfo.Region) != aws.StringValue(pc.RequesterVpcInfo.Region)
+}
+
 func vpcPeeringConnectionWaitUntilAvailable(conn *ec2.EC2, id string, timeout time.Duration) error {
 	// Wait for the vpc peering connection to become available
 	log.Printf("[DEBUG] Waiting for VPC Peering Connection (%s) to become available.", id)
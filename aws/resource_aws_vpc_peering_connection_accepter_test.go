@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -38,12 +39,15 @@ func TestAccAWSVPCPeeringConnectionAccepter_differentRegion(t *testing.T) {
 	var providers []*schema.Provider
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateRegionPreCheck(t)
+		},
 		ProviderFactories: testAccProviderFactories(&providers),
 		CheckDestroy:      testAccAwsVPCPeeringConnectionAccepterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAwsVPCPeeringConnectionAccepterDifferentRegion,
+				Config: testAccAwsVPCPeeringConnectionAccepterDifferentRegion(),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAWSVpcPeeringConnectionExists(
 						"aws_vpc_peering_connection_accepter.peer",
@@ -91,19 +95,9 @@ resource "aws_vpc_peering_connection_accepter" "peer" {
 }
 `
 
-const testAccAwsVPCPeeringConnectionAccepterDifferentRegion = `
-provider "aws" {
-	alias = "main"
-	region = "us-west-2"
-}
-
-provider "aws" {
-	alias = "peer"
-	region = "us-east-1"
-}
-
+func testAccAwsVPCPeeringConnectionAccepterDifferentRegion() string {
+	return testAccAlternateRegionProviderConfig() + fmt.Sprintf(`
 resource "aws_vpc" "main" {
-	provider = "aws.main"
 	cidr_block = "10.0.0.0/16"
 	tags = {
 		Name = "terraform-testacc-vpc-peering-conn-accepter-diff-region-main"
@@ -111,7 +105,7 @@ resource "aws_vpc" "main" {
 }
 
 resource "aws_vpc" "peer" {
-	provider = "aws.peer"
+	provider = "aws.alternate"
 	cidr_block = "10.1.0.0/16"
 	tags = {
 		Name = "terraform-testacc-vpc-peering-conn-accepter-diff-region-peer"
@@ -120,17 +114,17 @@ resource "aws_vpc" "peer" {
 
 // Requester's side of the connection.
 resource "aws_vpc_peering_connection" "peer" {
-	provider = "aws.main"
 	vpc_id = "${aws_vpc.main.id}"
 	peer_vpc_id = "${aws_vpc.peer.id}"
-	peer_region = "us-east-1"
+	peer_region = %[1]q
 	auto_accept = false
 }
 
 // Accepter's side of the connection.
 resource "aws_vpc_peering_connection_accepter" "peer" {
-	provider = "aws.peer"
+	provider = "aws.alternate"
 	vpc_peering_connection_id = "${aws_vpc_peering_connection.peer.id}"
 	auto_accept = true
 }
-`
+`, testAccGetAlternateRegion())
+}
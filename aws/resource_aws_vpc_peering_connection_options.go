@@ -71,7 +71,20 @@ func resourceAwsVpcPeeringConnectionOptionsRead(d *schema.ResourceData, meta int
 }
 
 func resourceAwsVpcPeeringConnectionOptionsUpdate(d *schema.ResourceData, meta interface{}) error {
-	if err := resourceAwsVpcPeeringConnectionModifyOptions(d, meta); err != nil {
+	conn := meta.(*AWSClient).ec2conn
+
+	pcRaw, _, err := vpcPeeringConnectionRefreshState(conn, d.Id())()
+	if err != nil {
+		return fmt.Errorf("Error reading VPC Peering Connection: %s", err.Error())
+	}
+
+	if pcRaw == nil {
+		return fmt.Errorf("VPC Peering Connection (%s) not found", d.Id())
+	}
+
+	crossRegionPeering := vpcPeeringConnectionIsCrossRegion(pcRaw.(*ec2.VpcPeeringConnection))
+
+	if err := resourceAwsVpcPeeringConnectionModifyOptions(d, meta, crossRegionPeering); err != nil {
 		return fmt.Errorf("Error modifying VPC Peering Connection Options: %s", err.Error())
 	}
 
@@ -1,11 +1,13 @@
 package aws
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 )
 
 func TestAccAWSVpcPeeringConnectionOptions_importBasic(t *testing.T) {
@@ -87,6 +89,46 @@ func TestAccAWSVpcPeeringConnectionOptions_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSVpcPeeringConnectionOptions_differentRegion(t *testing.T) {
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateRegionPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckAWSVpcPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcPeeringConnectionOptionsConfigDifferentRegion(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"aws_vpc_peering_connection_options.requester",
+						"requester.#",
+						"1",
+					),
+					resource.TestCheckResourceAttr(
+						"aws_vpc_peering_connection_options.requester",
+						"requester.1102046665.allow_remote_vpc_dns_resolution",
+						"true",
+					),
+					resource.TestCheckResourceAttr(
+						"aws_vpc_peering_connection_options.accepter",
+						"accepter.#",
+						"1",
+					),
+					resource.TestCheckResourceAttr(
+						"aws_vpc_peering_connection_options.accepter",
+						"accepter.1102046665.allow_remote_vpc_dns_resolution",
+						"true",
+					),
+				),
+			},
+		},
+	})
+}
+
 const testAccVpcPeeringConnectionOptionsConfig = `
 resource "aws_vpc" "foo" {
   cidr_block = "10.0.0.0/16"
@@ -122,3 +164,55 @@ resource "aws_vpc_peering_connection_options" "foo" {
   }
 }
 `
+
+func testAccVpcPeeringConnectionOptionsConfigDifferentRegion() string {
+	return testAccAlternateRegionProviderConfig() + fmt.Sprintf(`
+resource "aws_vpc" "foo" {
+  cidr_block           = "10.0.0.0/16"
+  enable_dns_hostnames = true
+  tags = {
+    Name = "terraform-testacc-vpc-peering-conn-options-diff-region-foo"
+  }
+}
+
+resource "aws_vpc" "bar" {
+  provider             = "aws.alternate"
+  cidr_block           = "10.1.0.0/16"
+  enable_dns_hostnames = true
+  tags = {
+    Name = "terraform-testacc-vpc-peering-conn-options-diff-region-bar"
+  }
+}
+
+resource "aws_vpc_peering_connection" "foo" {
+  vpc_id      = "${aws_vpc.foo.id}"
+  peer_vpc_id = "${aws_vpc.bar.id}"
+  peer_region = %[1]q
+  auto_accept = false
+}
+
+resource "aws_vpc_peering_connection_accepter" "bar" {
+  provider                  = "aws.alternate"
+  vpc_peering_connection_id = "${aws_vpc_peering_connection.foo.id}"
+  auto_accept               = true
+}
+
+// Each side's options must be set from that side's region.
+resource "aws_vpc_peering_connection_options" "requester" {
+  vpc_peering_connection_id = "${aws_vpc_peering_connection_accepter.bar.id}"
+
+  requester {
+    allow_remote_vpc_dns_resolution = true
+  }
+}
+
+resource "aws_vpc_peering_connection_options" "accepter" {
+  provider                  = "aws.alternate"
+  vpc_peering_connection_id = "${aws_vpc_peering_connection_accepter.bar.id}"
+
+  accepter {
+    allow_remote_vpc_dns_resolution = true
+  }
+}
+`, testAccGetAlternateRegion())
+}
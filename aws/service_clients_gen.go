@@ -0,0 +1,505 @@
+// Code generated by internal/generators/serviceclients/main.go; DO NOT EDIT.
+
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/appmesh"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/aws/aws-sdk-go/service/budgets"
+	"github.com/aws/aws-sdk-go/service/cloud9"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
+	"github.com/aws/aws-sdk-go/service/cloudsearch"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/codebuild"
+	"github.com/aws/aws-sdk-go/service/codecommit"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+	"github.com/aws/aws-sdk-go/service/codepipeline"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/aws/aws-sdk-go/service/costandusagereportservice"
+	"github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/aws/aws-sdk-go/service/datapipeline"
+	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/aws/aws-sdk-go/service/devicefarm"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/aws/aws-sdk-go/service/dlm"
+	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/aws/aws-sdk-go/service/elastictranscoder"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/emr"
+	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/aws/aws-sdk-go/service/glacier"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/inspector"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/kinesisanalytics"
+	"github.com/aws/aws-sdk-go/service/kinesisanalyticsv2"
+	"github.com/aws/aws-sdk-go/service/kinesisvideo"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/aws/aws-sdk-go/service/licensemanager"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/aws/aws-sdk-go/service/macie"
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/aws/aws-sdk-go/service/mediapackage"
+	"github.com/aws/aws-sdk-go/service/mediastore"
+	"github.com/aws/aws-sdk-go/service/mediastoredata"
+	"github.com/aws/aws-sdk-go/service/mq"
+	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/aws/aws-sdk-go/service/opsworks"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/aws/aws-sdk-go/service/pricing"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/aws/aws-sdk-go/service/resourcegroups"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53resolver"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/aws/aws-sdk-go/service/serverlessapplicationrepository"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/aws/aws-sdk-go/service/simpledb"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/swf"
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/aws/aws-sdk-go/service/worklink"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/aws/aws-sdk-go/service/xray"
+)
+
+// endpointServiceNames are the provider endpoints configuration block arguments.
+var endpointServiceNames = []string{
+	"acm",
+	"acmpca",
+	"apigateway",
+	"applicationautoscaling",
+	"appmesh",
+	"appsync",
+	"athena",
+	"autoscaling",
+	"backup",
+	"batch",
+	"budgets",
+	"cloud9",
+	"cloudformation",
+	"cloudfront",
+	"cloudhsm",
+	"cloudsearch",
+	"cloudtrail",
+	"cloudwatch",
+	"cloudwatchevents",
+	"cloudwatchlogs",
+	"codebuild",
+	"codecommit",
+	"codedeploy",
+	"codepipeline",
+	"cognitoidentity",
+	"cognitoidp",
+	"configservice",
+	"cur",
+	"datapipeline",
+	"datasync",
+	"dax",
+	"devicefarm",
+	"directconnect",
+	"dlm",
+	"dms",
+	"docdb",
+	"ds",
+	"dynamodb",
+	"ec2",
+	"ecr",
+	"ecs",
+	"efs",
+	"eks",
+	"elasticache",
+	"elasticbeanstalk",
+	"elastictranscoder",
+	"elb",
+	"emr",
+	"es",
+	"firehose",
+	"fms",
+	"fsx",
+	"gamelift",
+	"glacier",
+	"globalaccelerator",
+	"glue",
+	"guardduty",
+	"iam",
+	"inspector",
+	"iot",
+	"kafka",
+	"kinesis",
+	"kinesis_analytics",
+	"kinesisanalytics",
+	"kinesisvideo",
+	"kms",
+	"lambda",
+	"lexmodels",
+	"licensemanager",
+	"lightsail",
+	"macie",
+	"managedblockchain",
+	"mediaconnect",
+	"mediaconvert",
+	"medialive",
+	"mediapackage",
+	"mediastore",
+	"mediastoredata",
+	"mq",
+	"neptune",
+	"opsworks",
+	"organizations",
+	"pinpoint",
+	"pricing",
+	"quicksight",
+	"r53",
+	"ram",
+	"rds",
+	"redshift",
+	"resourcegroups",
+	"route53",
+	"route53resolver",
+	"s3",
+	"s3control",
+	"sagemaker",
+	"sdb",
+	"secretsmanager",
+	"securityhub",
+	"serverlessrepo",
+	"servicecatalog",
+	"servicediscovery",
+	"ses",
+	"shield",
+	"sns",
+	"sqs",
+	"ssm",
+	"stepfunctions",
+	"storagegateway",
+	"sts",
+	"swf",
+	"transfer",
+	"waf",
+	"wafregional",
+	"worklink",
+	"workspaces",
+	"xray",
+}
+
+// deprecatedEndpointServiceNames maps the deprecated endpoints configuration
+// block arguments to the argument of the service clients they override.
+var deprecatedEndpointServiceNames = map[string]string{
+	"kinesis_analytics": "kinesisanalytics",
+	"r53":               "route53",
+}
+
+// configureServiceClients creates the AWSClient service clients from the
+// session, applying any custom endpoints.
+func (c *Config) configureServiceClients(sess *session.Session, client *AWSClient) {
+	client.acmconn = acm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["acm"])}))
+	client.acmpcaconn = acmpca.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["acmpca"])}))
+	client.apigateway = apigateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["apigateway"])}))
+	client.apigatewayv2conn = apigatewayv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["apigateway"])}))
+	client.appautoscalingconn = applicationautoscaling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["applicationautoscaling"])}))
+	client.appmeshconn = appmesh.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["appmesh"])}))
+	client.appsyncconn = appsync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["appsync"])}))
+	client.athenaconn = athena.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["athena"])}))
+	client.autoscalingconn = autoscaling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["autoscaling"])}))
+	client.backupconn = backup.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["backup"])}))
+	client.batchconn = batch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["batch"])}))
+	client.budgetconn = budgets.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["budgets"])}))
+	client.cfconn = cloudformation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudformation"])}))
+	client.cloud9conn = cloud9.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloud9"])}))
+	client.cloudfrontconn = cloudfront.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudfront"])}))
+	client.cloudhsmv2conn = cloudhsmv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudhsm"])}))
+	client.cloudsearchconn = cloudsearch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudsearch"])}))
+	client.cloudtrailconn = cloudtrail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudtrail"])}))
+	client.cloudwatchconn = cloudwatch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudwatch"])}))
+	client.cloudwatcheventsconn = cloudwatchevents.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudwatchevents"])}))
+	client.cloudwatchlogsconn = cloudwatchlogs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudwatchlogs"])}))
+	client.codebuildconn = codebuild.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["codebuild"])}))
+	client.codecommitconn = codecommit.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["codecommit"])}))
+	client.codedeployconn = codedeploy.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["codedeploy"])}))
+	client.codepipelineconn = codepipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["codepipeline"])}))
+	client.cognitoconn = cognitoidentity.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cognitoidentity"])}))
+	client.cognitoidpconn = cognitoidentityprovider.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cognitoidp"])}))
+	client.configconn = configservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["configservice"])}))
+	client.costandusagereportconn = costandusagereportservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cur"])}))
+	client.datapipelineconn = datapipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["datapipeline"])}))
+	client.datasyncconn = datasync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["datasync"])}))
+	client.daxconn = dax.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dax"])}))
+	client.devicefarmconn = devicefarm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["devicefarm"])}))
+	client.dlmconn = dlm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dlm"])}))
+	client.dmsconn = databasemigrationservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dms"])}))
+	client.docdbconn = docdb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["docdb"])}))
+	client.dsconn = directoryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ds"])}))
+	client.dxconn = directconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["directconnect"])}))
+	client.dynamodbconn = dynamodb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dynamodb"])}))
+	client.ec2conn = ec2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ec2"])}))
+	client.ecrconn = ecr.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ecr"])}))
+	client.ecsconn = ecs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ecs"])}))
+	client.efsconn = efs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["efs"])}))
+	client.eksconn = eks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["eks"])}))
+	client.elasticacheconn = elasticache.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["elasticache"])}))
+	client.elasticbeanstalkconn = elasticbeanstalk.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["elasticbeanstalk"])}))
+	client.elastictranscoderconn = elastictranscoder.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["elastictranscoder"])}))
+	client.elbconn = elb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["elb"])}))
+	client.elbv2conn = elbv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["elb"])}))
+	client.emrconn = emr.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["emr"])}))
+	client.esconn = elasticsearchservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["es"])}))
+	client.firehoseconn = firehose.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["firehose"])}))
+	client.fmsconn = fms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["fms"])}))
+	client.fsxconn = fsx.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["fsx"])}))
+	client.gameliftconn = gamelift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["gamelift"])}))
+	client.glacierconn = glacier.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["glacier"])}))
+	client.globalacceleratorconn = globalaccelerator.New(sess.Copy(&aws.Config{Region: aws.String("us-west-2"), Endpoint: aws.String(c.Endpoints["globalaccelerator"])}))
+	client.glueconn = glue.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["glue"])}))
+	client.guarddutyconn = guardduty.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["guardduty"])}))
+	client.iamconn = iam.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["iam"])}))
+	client.inspectorconn = inspector.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["inspector"])}))
+	client.iotconn = iot.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["iot"])}))
+	client.kafkaconn = kafka.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["kafka"])}))
+	client.kinesisanalyticsconn = kinesisanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["kinesisanalytics"])}))
+	client.kinesisanalyticsv2conn = kinesisanalyticsv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["kinesisanalytics"])}))
+	client.kinesisconn = kinesis.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["kinesis"])}))
+	client.kinesisvideoconn = kinesisvideo.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["kinesisvideo"])}))
+	client.kmsconn = kms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["kms"])}))
+	client.lambdaconn = lambda.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["lambda"])}))
+	client.lexmodelconn = lexmodelbuildingservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["lexmodels"])}))
+	client.licensemanagerconn = licensemanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["licensemanager"])}))
+	client.lightsailconn = lightsail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["lightsail"])}))
+	client.macieconn = macie.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["macie"])}))
+	client.managedblockchainconn = managedblockchain.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["managedblockchain"])}))
+	client.mediaconnectconn = mediaconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mediaconnect"])}))
+	client.mediaconvertconn = mediaconvert.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mediaconvert"])}))
+	client.medialiveconn = medialive.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["medialive"])}))
+	client.mediapackageconn = mediapackage.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mediapackage"])}))
+	client.mediastoreconn = mediastore.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mediastore"])}))
+	client.mediastoredataconn = mediastoredata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mediastoredata"])}))
+	client.mqconn = mq.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mq"])}))
+	client.neptuneconn = neptune.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["neptune"])}))
+	client.opsworksconn = opsworks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["opsworks"])}))
+	client.organizationsconn = organizations.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["organizations"])}))
+	client.pinpointconn = pinpoint.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["pinpoint"])}))
+	client.pricingconn = pricing.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["pricing"])}))
+	client.quicksightconn = quicksight.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["quicksight"])}))
+	client.r53conn = route53.New(sess.Copy(&aws.Config{Region: aws.String("us-east-1"), Endpoint: aws.String(c.Endpoints["route53"])}))
+	client.ramconn = ram.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ram"])}))
+	client.rdsconn = rds.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["rds"])}))
+	client.redshiftconn = redshift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["redshift"])}))
+	client.resourcegroupsconn = resourcegroups.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["resourcegroups"])}))
+	client.route53resolverconn = route53resolver.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["route53resolver"])}))
+	client.s3conn = s3.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["s3"]), S3ForcePathStyle: aws.Bool(c.S3ForcePathStyle)}))
+	client.s3controlconn = s3control.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["s3control"])}))
+	client.sagemakerconn = sagemaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sagemaker"])}))
+	client.scconn = servicecatalog.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["servicecatalog"])}))
+	client.sdconn = servicediscovery.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["servicediscovery"])}))
+	client.secretsmanagerconn = secretsmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["secretsmanager"])}))
+	client.securityhubconn = securityhub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["securityhub"])}))
+	client.serverlessapplicationrepositoryconn = serverlessapplicationrepository.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["serverlessrepo"])}))
+	client.sesConn = ses.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ses"])}))
+	client.sfnconn = sfn.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["stepfunctions"])}))
+	client.shieldconn = shield.New(sess.Copy(&aws.Config{Region: aws.String("us-east-1"), Endpoint: aws.String(c.Endpoints["shield"])}))
+	client.simpledbconn = simpledb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sdb"])}))
+	client.snsconn = sns.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sns"])}))
+	client.sqsconn = sqs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sqs"])}))
+	client.ssmconn = ssm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ssm"])}))
+	client.storagegatewayconn = storagegateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["storagegateway"])}))
+	client.stsconn = sts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sts"])}))
+	client.swfconn = swf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["swf"])}))
+	client.transferconn = transfer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["transfer"])}))
+	client.wafconn = waf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["waf"])}))
+	client.wafregionalconn = wafregional.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["wafregional"])}))
+	client.worklinkconn = worklink.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["worklink"])}))
+	client.workspacesconn = workspaces.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["workspaces"])}))
+	client.xrayconn = xray.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["xray"])}))
+
+	// Handle deprecated endpoint configurations
+	if c.Endpoints["kinesis_analytics"] != "" {
+		client.kinesisanalyticsconn = kinesisanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["kinesis_analytics"])}))
+	}
+	if c.Endpoints["r53"] != "" {
+		client.r53conn = route53.New(sess.Copy(&aws.Config{Region: aws.String("us-east-1"), Endpoint: aws.String(c.Endpoints["r53"])}))
+	}
+}
+
+// endpointServiceClients returns the service clients keyed by their
+// endpoints configuration block argument. Deprecated arguments are not
+// included, see deprecatedEndpointServiceNames.
+func (c *AWSClient) endpointServiceClients() map[string][]*client.Client {
+	return map[string][]*client.Client{
+		"acm":                    {c.acmconn.Client},
+		"acmpca":                 {c.acmpcaconn.Client},
+		"apigateway":             {c.apigateway.Client, c.apigatewayv2conn.Client},
+		"applicationautoscaling": {c.appautoscalingconn.Client},
+		"appmesh":                {c.appmeshconn.Client},
+		"appsync":                {c.appsyncconn.Client},
+		"athena":                 {c.athenaconn.Client},
+		"autoscaling":            {c.autoscalingconn.Client},
+		"backup":                 {c.backupconn.Client},
+		"batch":                  {c.batchconn.Client},
+		"budgets":                {c.budgetconn.Client},
+		"cloud9":                 {c.cloud9conn.Client},
+		"cloudformation":         {c.cfconn.Client},
+		"cloudfront":             {c.cloudfrontconn.Client},
+		"cloudhsm":               {c.cloudhsmv2conn.Client},
+		"cloudsearch":            {c.cloudsearchconn.Client},
+		"cloudtrail":             {c.cloudtrailconn.Client},
+		"cloudwatch":             {c.cloudwatchconn.Client},
+		"cloudwatchevents":       {c.cloudwatcheventsconn.Client},
+		"cloudwatchlogs":         {c.cloudwatchlogsconn.Client},
+		"codebuild":              {c.codebuildconn.Client},
+		"codecommit":             {c.codecommitconn.Client},
+		"codedeploy":             {c.codedeployconn.Client},
+		"codepipeline":           {c.codepipelineconn.Client},
+		"cognitoidentity":        {c.cognitoconn.Client},
+		"cognitoidp":             {c.cognitoidpconn.Client},
+		"configservice":          {c.configconn.Client},
+		"cur":                    {c.costandusagereportconn.Client},
+		"datapipeline":           {c.datapipelineconn.Client},
+		"datasync":               {c.datasyncconn.Client},
+		"dax":                    {c.daxconn.Client},
+		"devicefarm":             {c.devicefarmconn.Client},
+		"directconnect":          {c.dxconn.Client},
+		"dlm":                    {c.dlmconn.Client},
+		"dms":                    {c.dmsconn.Client},
+		"docdb":                  {c.docdbconn.Client},
+		"ds":                     {c.dsconn.Client},
+		"dynamodb":               {c.dynamodbconn.Client},
+		"ec2":                    {c.ec2conn.Client},
+		"ecr":                    {c.ecrconn.Client},
+		"ecs":                    {c.ecsconn.Client},
+		"efs":                    {c.efsconn.Client},
+		"eks":                    {c.eksconn.Client},
+		"elasticache":            {c.elasticacheconn.Client},
+		"elasticbeanstalk":       {c.elasticbeanstalkconn.Client},
+		"elastictranscoder":      {c.elastictranscoderconn.Client},
+		"elb":                    {c.elbconn.Client, c.elbv2conn.Client},
+		"emr":                    {c.emrconn.Client},
+		"es":                     {c.esconn.Client},
+		"firehose":               {c.firehoseconn.Client},
+		"fms":                    {c.fmsconn.Client},
+		"fsx":                    {c.fsxconn.Client},
+		"gamelift":               {c.gameliftconn.Client},
+		"glacier":                {c.glacierconn.Client},
+		"globalaccelerator":      {c.globalacceleratorconn.Client},
+		"glue":                   {c.glueconn.Client},
+		"guardduty":              {c.guarddutyconn.Client},
+		"iam":                    {c.iamconn.Client},
+		"inspector":              {c.inspectorconn.Client},
+		"iot":                    {c.iotconn.Client},
+		"kafka":                  {c.kafkaconn.Client},
+		"kinesis":                {c.kinesisconn.Client},
+		"kinesisanalytics":       {c.kinesisanalyticsconn.Client, c.kinesisanalyticsv2conn.Client},
+		"kinesisvideo":           {c.kinesisvideoconn.Client},
+		"kms":                    {c.kmsconn.Client},
+		"lambda":                 {c.lambdaconn.Client},
+		"lexmodels":              {c.lexmodelconn.Client},
+		"licensemanager":         {c.licensemanagerconn.Client},
+		"lightsail":              {c.lightsailconn.Client},
+		"macie":                  {c.macieconn.Client},
+		"managedblockchain":      {c.managedblockchainconn.Client},
+		"mediaconnect":           {c.mediaconnectconn.Client},
+		"mediaconvert":           {c.mediaconvertconn.Client},
+		"medialive":              {c.medialiveconn.Client},
+		"mediapackage":           {c.mediapackageconn.Client},
+		"mediastore":             {c.mediastoreconn.Client},
+		"mediastoredata":         {c.mediastoredataconn.Client},
+		"mq":                     {c.mqconn.Client},
+		"neptune":                {c.neptuneconn.Client},
+		"opsworks":               {c.opsworksconn.Client},
+		"organizations":          {c.organizationsconn.Client},
+		"pinpoint":               {c.pinpointconn.Client},
+		"pricing":                {c.pricingconn.Client},
+		"quicksight":             {c.quicksightconn.Client},
+		"ram":                    {c.ramconn.Client},
+		"rds":                    {c.rdsconn.Client},
+		"redshift":               {c.redshiftconn.Client},
+		"resourcegroups":         {c.resourcegroupsconn.Client},
+		"route53":                {c.r53conn.Client},
+		"route53resolver":        {c.route53resolverconn.Client},
+		"s3":                     {c.s3conn.Client},
+		"s3control":              {c.s3controlconn.Client},
+		"sagemaker":              {c.sagemakerconn.Client},
+		"sdb":                    {c.simpledbconn.Client},
+		"secretsmanager":         {c.secretsmanagerconn.Client},
+		"securityhub":            {c.securityhubconn.Client},
+		"serverlessrepo":         {c.serverlessapplicationrepositoryconn.Client},
+		"servicecatalog":         {c.scconn.Client},
+		"servicediscovery":       {c.sdconn.Client},
+		"ses":                    {c.sesConn.Client},
+		"shield":                 {c.shieldconn.Client},
+		"sns":                    {c.snsconn.Client},
+		"sqs":                    {c.sqsconn.Client},
+		"ssm":                    {c.ssmconn.Client},
+		"stepfunctions":          {c.sfnconn.Client},
+		"storagegateway":         {c.storagegatewayconn.Client},
+		"sts":                    {c.stsconn.Client},
+		"swf":                    {c.swfconn.Client},
+		"transfer":               {c.transferconn.Client},
+		"waf":                    {c.wafconn.Client},
+		"wafregional":            {c.wafregionalconn.Client},
+		"worklink":               {c.worklinkconn.Client},
+		"workspaces":             {c.workspacesconn.Client},
+		"xray":                   {c.xrayconn.Client},
+	}
+}
@@ -0,0 +1,211 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	retryModeAdaptive = "adaptive"
+	retryModeStandard = "standard"
+)
+
+// adaptiveRetryModeRequestsPerSecond are the starting client-side request
+// rates for APIs with low account-wide throttling limits when retry_mode is
+// adaptive and no requests_per_second is configured for the service.
+var adaptiveRetryModeRequestsPerSecond = map[string]float64{
+	"cloudfront":    10,
+	"organizations": 10,
+	"route53":       5,
+}
+
+// requestRateLimiterMinRequestsPerSecond is the lowest rate an adaptive
+// limiter backs off to.
+const requestRateLimiterMinRequestsPerSecond = 0.5
+
+// ServiceLimit overrides the retry and request rate behavior of the clients
+// for a single service. A nil MaxRetries or zero RequestsPerSecond keeps the
+// provider defaults.
+type ServiceLimit struct {
+	MaxRetries        *int
+	RequestsPerSecond float64
+}
+
+// requestRateLimiter spaces out requests to a single service. When adaptive,
+// the rate is halved on each throttled attempt and recovers gradually toward
+// the configured rate as attempts succeed.
+type requestRateLimiter struct {
+	adaptive bool
+	maxRate  float64
+
+	mu   sync.Mutex
+	next time.Time
+	rate float64
+}
+
+func newRequestRateLimiter(requestsPerSecond float64, adaptive bool) *requestRateLimiter {
+	return &requestRateLimiter{
+		adaptive: adaptive,
+		maxRate:  requestsPerSecond,
+		rate:     requestsPerSecond,
+	}
+}
+
+// reserve returns how long the caller must wait before sending its request.
+func (l *requestRateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.next.Before(now) {
+		l.next = now
+	}
+
+	delay := l.next.Sub(now)
+	l.next = l.next.Add(time.Duration(float64(time.Second) / l.rate))
+
+	return delay
+}
+
+func (l *requestRateLimiter) throttled() {
+	if !l.adaptive {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.rate /= 2
+	if l.rate < requestRateLimiterMinRequestsPerSecond {
+		l.rate = requestRateLimiterMinRequestsPerSecond
+	}
+}
+
+func (l *requestRateLimiter) succeeded() {
+	if !l.adaptive {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.rate += l.maxRate / 10
+	if l.rate > l.maxRate {
+		l.rate = l.maxRate
+	}
+}
+
+func (l *requestRateLimiter) currentRate() float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.rate
+}
+
+func (l *requestRateLimiter) addHandlers(handlers *request.Handlers) {
+	// Send runs for each attempt, so retries are rate limited as well.
+	handlers.Send.PushFrontNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.RequestRateLimiter",
+		Fn: func(r *request.Request) {
+			if delay := l.reserve(time.Now()); delay > 0 {
+				if err := aws.SleepWithContext(r.Context(), delay); err != nil {
+					r.Error = awserr.New(request.CanceledErrorCode, "request context canceled", err)
+				}
+			}
+		},
+	})
+
+	handlers.CompleteAttempt.PushBackNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.RequestRateLimiterFeedback",
+		Fn: func(r *request.Request) {
+			if r.Error == nil {
+				l.succeeded()
+			} else if r.IsErrorThrottle() {
+				l.throttled()
+			}
+		},
+	})
+}
+
+// configureServiceLimits applies per-service retry and request rate
+// overrides to the service clients. Services sharing an endpoint
+// configuration key (e.g. elb) share a single limiter.
+func (c *AWSClient) configureServiceLimits(retryMode string, serviceLimits map[string]*ServiceLimit) error {
+	limits := make(map[string]*ServiceLimit, len(serviceLimits))
+
+	for service, limit := range serviceLimits {
+		// Deprecated endpoint names (e.g. r53) configure the same clients as
+		// the endpoint they override, so they must not get a second limiter.
+		if v, ok := deprecatedEndpointServiceNames[service]; ok {
+			service = v
+		}
+
+		if _, ok := limits[service]; ok {
+			return fmt.Errorf("service_limits: duplicate configuration for service %q", service)
+		}
+
+		l := *limit
+		limits[service] = &l
+	}
+
+	if retryMode == retryModeAdaptive {
+		for service, requestsPerSecond := range adaptiveRetryModeRequestsPerSecond {
+			limit, ok := limits[service]
+
+			if !ok {
+				limit = &ServiceLimit{}
+				limits[service] = limit
+			}
+
+			if limit.RequestsPerSecond == 0 {
+				limit.RequestsPerSecond = requestsPerSecond
+			}
+		}
+	}
+
+	serviceClients := c.endpointServiceClients()
+
+	for service, limit := range limits {
+		clients, ok := serviceClients[service]
+
+		if !ok {
+			return fmt.Errorf("service_limits: unsupported service %q", service)
+		}
+
+		var limiter *requestRateLimiter
+
+		if limit.RequestsPerSecond > 0 {
+			limiter = newRequestRateLimiter(limit.RequestsPerSecond, retryMode == retryModeAdaptive)
+		}
+
+		log.Printf("[INFO] Configuring %s service limits (max retries: %s, requests per second: %g, retry mode: %s)", service, serviceLimitMaxRetriesString(limit.MaxRetries), limit.RequestsPerSecond, retryMode)
+
+		for _, cl := range clients {
+			if limit.MaxRetries != nil {
+				cl.Config.MaxRetries = aws.Int(*limit.MaxRetries)
+				cl.Retryer = client.DefaultRetryer{NumMaxRetries: *limit.MaxRetries}
+			}
+
+			if limiter != nil {
+				limiter.addHandlers(&cl.Handlers)
+			}
+		}
+	}
+
+	return nil
+}
+
+func serviceLimitMaxRetriesString(maxRetries *int) string {
+	if maxRetries == nil {
+		return "default"
+	}
+
+	return strconv.Itoa(*maxRetries)
+}
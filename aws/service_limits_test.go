@@ -0,0 +1,214 @@
+package aws
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+func TestRequestRateLimiterReserve(t *testing.T) {
+	limiter := newRequestRateLimiter(4, false)
+	now := time.Now()
+
+	expected := []time.Duration{0, 250 * time.Millisecond, 500 * time.Millisecond}
+
+	for i, want := range expected {
+		if got := limiter.reserve(now); got != want {
+			t.Errorf("reservation %d: got delay %s, expected %s", i, got, want)
+		}
+	}
+
+	// An idle limiter does not accumulate a burst allowance.
+	if got := limiter.reserve(now.Add(10 * time.Second)); got != 0 {
+		t.Errorf("reservation after idle period: got delay %s, expected 0s", got)
+	}
+}
+
+func TestRequestRateLimiterAdaptive(t *testing.T) {
+	limiter := newRequestRateLimiter(8, true)
+
+	limiter.throttled()
+	if got, want := limiter.currentRate(), 4.0; got != want {
+		t.Errorf("after throttle: got rate %g, expected %g", got, want)
+	}
+
+	for i := 0; i < 10; i++ {
+		limiter.throttled()
+	}
+	if got, want := limiter.currentRate(), requestRateLimiterMinRequestsPerSecond; got != want {
+		t.Errorf("after repeated throttles: got rate %g, expected %g", got, want)
+	}
+
+	for i := 0; i < 20; i++ {
+		limiter.succeeded()
+	}
+	if got, want := limiter.currentRate(), 8.0; got != want {
+		t.Errorf("after recovery: got rate %g, expected %g", got, want)
+	}
+}
+
+func TestRequestRateLimiterStandard(t *testing.T) {
+	limiter := newRequestRateLimiter(8, false)
+
+	limiter.throttled()
+	if got, want := limiter.currentRate(), 8.0; got != want {
+		t.Errorf("after throttle: got rate %g, expected %g", got, want)
+	}
+}
+
+func TestRequestRateLimiterCanceledRequest(t *testing.T) {
+	limiter := newRequestRateLimiter(0.1, false)
+	handlers := request.Handlers{}
+	limiter.addHandlers(&handlers)
+
+	// The next reservation has to wait 10 seconds.
+	limiter.reserve(time.Now())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	r := &request.Request{HTTPRequest: &http.Request{}}
+	r.SetContext(ctx)
+
+	start := time.Now()
+	handlers.Send.Run(r)
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("got rate limit delay %s for a canceled request, expected it to return immediately", elapsed)
+	}
+
+	if err, ok := r.Error.(awserr.Error); !ok || err.Code() != request.CanceledErrorCode {
+		t.Errorf("got error %v, expected %s", r.Error, request.CanceledErrorCode)
+	}
+}
+
+func TestConfigureServiceLimitsDeprecatedEndpointNames(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ServiceLimits map[string]*ServiceLimit
+		ExpectedError bool
+	}{
+		{
+			Name: "deprecated name",
+			ServiceLimits: map[string]*ServiceLimit{
+				"r53": {RequestsPerSecond: 5},
+			},
+		},
+		{
+			Name: "current name",
+			ServiceLimits: map[string]*ServiceLimit{
+				"route53": {RequestsPerSecond: 5},
+			},
+		},
+		{
+			Name: "deprecated and current name",
+			ServiceLimits: map[string]*ServiceLimit{
+				"r53":     {RequestsPerSecond: 5},
+				"route53": {RequestsPerSecond: 5},
+			},
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			client := testServiceLimitsAWSClient(t)
+			handlers := client.r53conn.Handlers.Send.Len()
+
+			err := client.configureServiceLimits(retryModeAdaptive, testCase.ServiceLimits)
+
+			if testCase.ExpectedError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := client.r53conn.Handlers.Send.Len(), handlers+1; got != want {
+				t.Errorf("got %d Send handlers, expected %d", got, want)
+			}
+		})
+	}
+}
+
+func testServiceLimitsAWSClient(t *testing.T) *AWSClient {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("test", "test", ""),
+		Region:      aws.String("us-east-1"),
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	client := &AWSClient{}
+	config := &Config{Endpoints: map[string]string{}}
+	config.configureServiceClients(sess, client)
+
+	return client
+}
+
+func TestConfigureServiceLimitsMaxRetries(t *testing.T) {
+	testCases := []struct {
+		Name               string
+		MaxRetries         *int
+		ExpectedMaxRetries *int
+	}{
+		{
+			Name: "unset",
+		},
+		{
+			Name:               "zero",
+			MaxRetries:         aws.Int(0),
+			ExpectedMaxRetries: aws.Int(0),
+		},
+		{
+			Name:               "positive",
+			MaxRetries:         aws.Int(3),
+			ExpectedMaxRetries: aws.Int(3),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			client := testServiceLimitsAWSClient(t)
+			defaultMaxRetries := client.ec2conn.Config.MaxRetries
+
+			err := client.configureServiceLimits(retryModeStandard, map[string]*ServiceLimit{
+				"ec2": {MaxRetries: testCase.MaxRetries},
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got := client.ec2conn.Config.MaxRetries
+
+			if testCase.ExpectedMaxRetries == nil {
+				if got != defaultMaxRetries {
+					t.Errorf("got max retries %d, expected the default %d", aws.IntValue(got), aws.IntValue(defaultMaxRetries))
+				}
+				return
+			}
+
+			if got == nil || *got != *testCase.ExpectedMaxRetries {
+				t.Errorf("got max retries %v, expected %d", got, *testCase.ExpectedMaxRetries)
+			}
+
+			if retries := client.ec2conn.MaxRetries(); retries != *testCase.ExpectedMaxRetries {
+				t.Errorf("got retryer max retries %d, expected %d", retries, *testCase.ExpectedMaxRetries)
+			}
+		})
+	}
+}
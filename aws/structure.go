@@ -1586,25 +1586,6 @@ func flattenAllCloudFormationParameters(cfParams []*cloudformation.Parameter) ma
 	return params
 }
 
-func expandCloudFormationTags(tags map[string]interface{}) []*cloudformation.Tag {
-	var cfTags []*cloudformation.Tag
-	for k, v := range tags {
-		cfTags = append(cfTags, &cloudformation.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		})
-	}
-	return cfTags
-}
-
-func flattenCloudFormationTags(cfTags []*cloudformation.Tag) map[string]string {
-	tags := make(map[string]string, len(cfTags))
-	for _, t := range cfTags {
-		tags[*t.Key] = *t.Value
-	}
-	return tags
-}
-
 func flattenCloudFormationOutputs(cfOutputs []*cloudformation.Output) map[string]string {
 	outputs := make(map[string]string, len(cfOutputs))
 	for _, o := range cfOutputs {
@@ -2717,6 +2698,33 @@ func flattenCognitoUserPoolUserPoolAddOns(s *cognitoidentityprovider.UserPoolAdd
 	return []map[string]interface{}{config}
 }
 
+func expandCognitoUserPoolSoftwareTokenMfaConfiguration(l []interface{}) *cognitoidentityprovider.SoftwareTokenMfaConfigType {
+	if len(l) == 0 || l[0] == nil {
+		// Explicitly disable software token MFA when the block is removed.
+		return &cognitoidentityprovider.SoftwareTokenMfaConfigType{
+			Enabled: aws.Bool(false),
+		}
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &cognitoidentityprovider.SoftwareTokenMfaConfigType{
+		Enabled: aws.Bool(m["enabled"].(bool)),
+	}
+}
+
+func flattenCognitoUserPoolSoftwareTokenMfaConfiguration(s *cognitoidentityprovider.SoftwareTokenMfaConfigType) []map[string]interface{} {
+	if s == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"enabled": aws.BoolValue(s.Enabled),
+	}
+
+	return []map[string]interface{}{m}
+}
+
 func flattenIoTRuleCloudWatchAlarmActions(actions []*iot.Action) []map[string]interface{} {
 	results := make([]map[string]interface{}, 0)
 
@@ -4625,13 +4633,18 @@ func flattenVpcPeeringConnectionOptions(options *ec2.VpcPeeringConnectionOptions
 	return []map[string]interface{}{m}
 }
 
-func expandVpcPeeringConnectionOptions(m map[string]interface{}) *ec2.PeeringConnectionOptionsRequest {
+func expandVpcPeeringConnectionOptions(m map[string]interface{}, crossRegionPeering bool) *ec2.PeeringConnectionOptionsRequest {
 	options := &ec2.PeeringConnectionOptionsRequest{}
 
 	if v, ok := m["allow_remote_vpc_dns_resolution"]; ok {
 		options.AllowDnsResolutionFromRemoteVpc = aws.Bool(v.(bool))
 	}
 
+	// ClassicLink options are not supported for cross-region VPC Peering Connections.
+	if crossRegionPeering {
+		return options
+	}
+
 	if v, ok := m["allow_classic_link_to_remote_vpc"]; ok {
 		options.AllowEgressFromLocalClassicLinkToRemoteVpc = aws.Bool(v.(bool))
 	}
@@ -0,0 +1,43 @@
+package aws
+
+import (
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+// setTagsLightsail updates the tags of the Lightsail resource with the given name.
+func setTagsLightsail(conn *lightsail.Lightsail, d *schema.ResourceData, name string) error {
+	if d.HasChange("tags") {
+		oraw, nraw := d.GetChange("tags")
+		o := keyvaluetags.New(oraw.(map[string]interface{}))
+		n := keyvaluetags.New(nraw.(map[string]interface{}))
+
+		if remove := o.Removed(n); len(remove) > 0 {
+			log.Printf("[DEBUG] Removing tags: %#v", remove.Keys())
+			_, err := conn.UntagResource(&lightsail.UntagResourceInput{
+				ResourceName: aws.String(name),
+				TagKeys:      aws.StringSlice(remove.Keys()),
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if create := o.Updated(n); len(create) > 0 {
+			log.Printf("[DEBUG] Creating tags: %#v", create.Keys())
+			_, err := conn.TagResource(&lightsail.TagResourceInput{
+				ResourceName: aws.String(name),
+				Tags:         create.LightsailTags(),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
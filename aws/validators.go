@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/aws/aws-sdk-go/service/cognitoidentity"
 	"github.com/aws/aws-sdk-go/service/configservice"
@@ -701,6 +702,34 @@ func validateArn(v interface{}, k string) (ws []string, errors []error) {
 	return
 }
 
+// validateArnService returns a SchemaValidateFunc which tests if the provided
+// value is an ARN of one of the given services, e.g. "acm" or "iam".
+func validateArnService(services ...string) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(string)
+
+		if value == "" {
+			return
+		}
+
+		parsedArn, err := arn.Parse(value)
+		if err != nil {
+			errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: %s", k, value, err))
+			return
+		}
+
+		for _, service := range services {
+			if parsedArn.Service == service {
+				return
+			}
+		}
+
+		errors = append(errors, fmt.Errorf("%q (%s) must be an ARN of service %s, got: %s", k, value, strings.Join(services, " or "), parsedArn.Service))
+
+		return
+	}
+}
+
 func validateEC2AutomateARN(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 
@@ -2383,3 +2412,18 @@ func validateRoute53ResolverName(v interface{}, k string) (ws []string, errors [
 
 	return
 }
+
+func validateSwfTimeout(v interface{}, k string) (ws []string, errors []error) {
+	// SWF timeouts are a duration in seconds or "NONE" for an unlimited duration.
+	value := v.(string)
+	if value == "NONE" {
+		return
+	}
+
+	if seconds, err := strconv.Atoi(value); err != nil || seconds < 0 {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a non-negative number of seconds or NONE, got %q", k, value))
+	}
+
+	return
+}
@@ -351,6 +351,36 @@ func TestValidateArn(t *testing.T) {
 	}
 }
 
+func TestValidateArnService(t *testing.T) {
+	f := validateArnService("acm", "iam")
+
+	validNames := []string{
+		"",
+		"arn:aws:acm:us-east-1:123456789012:certificate/12345678-1234-1234-1234-123456789012",
+		"arn:aws:iam::123456789012:server-certificate/example",
+		"arn:aws-us-gov:acm:us-gov-west-1:123456789012:certificate/12345678-1234-1234-1234-123456789012",
+	}
+	for _, v := range validNames {
+		_, errors := f(v, "certificate_arn")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid ACM or IAM ARN: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"arn",
+		"example",
+		"arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/example/73e2d6bc24d8a067",
+		"arn:aws:s3:::example",
+	}
+	for _, v := range invalidNames {
+		_, errors := f(v, "certificate_arn")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid ACM or IAM ARN", v)
+		}
+	}
+}
+
 func TestValidateEC2AutomateARN(t *testing.T) {
 	validNames := []string{
 		"arn:aws:automate:us-east-1:ec2:reboot",
@@ -3020,3 +3050,41 @@ func TestValidateRoute53ResolverName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateSwfTimeout(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "NONE",
+			ErrCount: 0,
+		},
+		{
+			Value:    "0",
+			ErrCount: 0,
+		},
+		{
+			Value:    "3600",
+			ErrCount: 0,
+		},
+		{
+			Value:    "-1",
+			ErrCount: 1,
+		},
+		{
+			Value:    "none",
+			ErrCount: 1,
+		},
+		{
+			Value:    "1h",
+			ErrCount: 1,
+		},
+	}
+	for _, tc := range cases {
+		_, errors := validateSwfTimeout(tc.Value, "default_task_start_to_close_timeout")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors for %q, got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}